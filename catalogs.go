@@ -0,0 +1,155 @@
+package checkit
+
+import "golang.org/x/text/language"
+
+// builtinCatalogs lists the catalogs every new Localizer starts with
+var builtinCatalogs = map[language.Tag]Catalog{
+	language.English:    catalogEn,
+	language.Vietnamese: catalogVi,
+}
+
+var catalogEn = Catalog{
+	"value":                       "The value",
+	"value_missing":               "{{.Label}} is missing.",
+	"accepted":                    "{{.Label}} must be yes, on or 1.",
+	"alpha":                       "{{.Label}} must contain only alphabetic characters.",
+	"alpha_dash":                  "{{.Label}} may contain only alpha-numeric characters, dashes and underscores.",
+	"alpha_numeric":               "{{.Label}} must contain only alpha-numeric characters.",
+	"alpha_underscore":            "{{.Label}} may contain only alpha-numeric characters and underscores.",
+	"any":                         "{{.Label}} must have at least one element.",
+	"array":                       "{{.Label}} must be an array.",
+	"at_least":                    "{{.Label}} must pass at least {{.Params.n}} of the rules.",
+	"base64":                      "{{.Label}} must be a base64 encoded value.",
+	"between":                     "{{.Label}} must be between {{.Params.min}} and {{.Params.max}}.",
+	"boolean":                     "{{.Label}} must be a boolean.",
+	"contains":                    "{{.Label}} must contain {{.Params.value}}.",
+	"date":                        "{{.Label}} must be a date.",
+	"each":                        "{{.Label}} must be a collection.",
+	"email":                       "{{.Label}} must be a valid email address.",
+	"empty":                       "{{.Label}} must be empty.",
+	"equals":                      "{{.Label}} must be equal to {{.Params.value}}.",
+	"equals_field":                "{{.Label}} must be equal to {{.Params.key_path}}.",
+	"exact_length":                "{{.Label}} must have a length of exactly {{.Params.length}}.",
+	"excluded_if":                 "{{.Label}} must not be present when {{.Params.key_path}} is {{.Params.value}}.",
+	"exists":                      "{{.Label}} does not exist.",
+	"exists_non_nil":              "{{.Label}} must not be nil.",
+	"finite":                      "{{.Label}} must be a finite number.",
+	"function":                    "{{.Label}} must be a function.",
+	"greater_than":                "{{.Label}} must be greater than {{.Params.value}}.",
+	"greater_than_equal_to":       "{{.Label}} must be greater than or equal to {{.Params.value}}.",
+	"greater_than_equal_to_field": "{{.Label}} must be greater than or equal to {{.Params.key_path}}.",
+	"greater_than_field":          "{{.Label}} must be greater than {{.Params.key_path}}.",
+	"integer":                     "{{.Label}} must be an integer.",
+	"ipv4":                        "{{.Label}} must be an IPv4 address.",
+	"ipv6":                        "{{.Label}} must be an IPv6 address.",
+	"less_than":                   "{{.Label}} must be less than {{.Params.value}}.",
+	"less_than_equal_to":          "{{.Label}} must be less than or equal to {{.Params.value}}.",
+	"less_than_equal_to_field":    "{{.Label}} must be less than or equal to {{.Params.key_path}}.",
+	"less_than_field":             "{{.Label}} must be less than {{.Params.key_path}}.",
+	"luhn":                        "{{.Label}} must pass the Luhn check.",
+	"map_keys":                    "{{.Label}} must be a map.",
+	"map_values":                  "{{.Label}} must be a map.",
+	"matches":                     "{{.Label}} must match the pattern {{.Params.pattern}}.",
+	"max":                         "{{.Label}} must be at most {{.Params.max}}.",
+	"max_length":                  "{{.Label}} must have a length of at most {{.Params.length}}.",
+	"min":                         "{{.Label}} must be at least {{.Params.min}}.",
+	"min_length":                  "{{.Label}} must have a length of at least {{.Params.length}}.",
+	"nan":                         "{{.Label}} must be NaN.",
+	"natural":                     "{{.Label}} must be a natural number.",
+	"natural_non_zero":            "{{.Label}} must be a natural number greater than or equal to 1.",
+	"not":                         "{{.Label}} must not pass the rule.",
+	"not_allowed":                 "{{.Label}} is not allowed.",
+	"not_equals_field":            "{{.Label}} must not be equal to {{.Params.key_path}}.",
+	"not_matches":                 "{{.Label}} must not match the pattern {{.Params.pattern}}.",
+	"not_zero":                    "{{.Label}} must not be a zero value.",
+	"numeric":                     "{{.Label}} must be a number.",
+	"object":                      "{{.Label}} must be an object.",
+	"one_of":                      "{{.Label}} must pass exactly one of the rules.",
+	"or":                          "{{.Label}} must pass at least one of the rules.",
+	"plain_object":                "{{.Label}} must be a map.",
+	"regex":                       "{{.Label}} must be a regular expression.",
+	"required":                    "{{.Label}} is required.",
+	"required_if":                 "{{.Label}} is required when {{.Params.key_path}} is {{.Params.value}}.",
+	"required_unless":             "{{.Label}} is required unless {{.Params.key_path}} is {{.Params.value}}.",
+	"required_with":               "{{.Label}} is required.",
+	"required_without":            "{{.Label}} is required.",
+	"size":                        "{{.Label}} must have a size of {{.Params.size}}.",
+	"string":                      "{{.Label}} must be a string.",
+	"unique":                      "{{.Label}} has already been taken.",
+	"url":                         "{{.Label}} must be a valid URL.",
+	"uuid":                        "{{.Label}} must be a valid UUID.",
+	"xor":                         "{{.Label}} must pass exactly one of the two rules.",
+}
+
+var catalogVi = Catalog{
+	"value":                       "Giá trị",
+	"value_missing":               "{{.Label}} bị thiếu.",
+	"accepted":                    "{{.Label}} phải là yes, on hoặc 1.",
+	"alpha":                       "{{.Label}} chỉ được chứa chữ cái.",
+	"alpha_dash":                  "{{.Label}} chỉ được chứa chữ cái, chữ số, dấu gạch ngang và dấu gạch dưới.",
+	"alpha_numeric":               "{{.Label}} chỉ được chứa chữ cái và chữ số.",
+	"alpha_underscore":            "{{.Label}} chỉ được chứa chữ cái, chữ số và dấu gạch dưới.",
+	"any":                         "{{.Label}} phải có ít nhất một phần tử.",
+	"array":                       "{{.Label}} phải là một mảng.",
+	"at_least":                    "{{.Label}} phải thỏa mãn ít nhất {{.Params.n}} quy tắc.",
+	"base64":                      "{{.Label}} phải được mã hóa base64.",
+	"between":                     "{{.Label}} phải nằm trong khoảng từ {{.Params.min}} đến {{.Params.max}}.",
+	"boolean":                     "{{.Label}} phải là giá trị đúng hoặc sai.",
+	"contains":                    "{{.Label}} phải chứa {{.Params.value}}.",
+	"date":                        "{{.Label}} phải là một ngày hợp lệ.",
+	"each":                        "{{.Label}} phải là một tập hợp.",
+	"email":                       "{{.Label}} phải là địa chỉ email hợp lệ.",
+	"empty":                       "{{.Label}} phải rỗng.",
+	"equals":                      "{{.Label}} phải bằng {{.Params.value}}.",
+	"equals_field":                "{{.Label}} phải bằng {{.Params.key_path}}.",
+	"exact_length":                "{{.Label}} phải có độ dài đúng bằng {{.Params.length}}.",
+	"excluded_if":                 "{{.Label}} không được có mặt khi {{.Params.key_path}} là {{.Params.value}}.",
+	"exists":                      "{{.Label}} không tồn tại.",
+	"exists_non_nil":              "{{.Label}} không được là nil.",
+	"finite":                      "{{.Label}} phải là số hữu hạn.",
+	"function":                    "{{.Label}} phải là một hàm.",
+	"greater_than":                "{{.Label}} phải lớn hơn {{.Params.value}}.",
+	"greater_than_equal_to":       "{{.Label}} phải lớn hơn hoặc bằng {{.Params.value}}.",
+	"greater_than_equal_to_field": "{{.Label}} phải lớn hơn hoặc bằng {{.Params.key_path}}.",
+	"greater_than_field":          "{{.Label}} phải lớn hơn {{.Params.key_path}}.",
+	"integer":                     "{{.Label}} phải là số nguyên.",
+	"ipv4":                        "{{.Label}} phải là địa chỉ IPv4.",
+	"ipv6":                        "{{.Label}} phải là địa chỉ IPv6.",
+	"less_than":                   "{{.Label}} phải nhỏ hơn {{.Params.value}}.",
+	"less_than_equal_to":          "{{.Label}} phải nhỏ hơn hoặc bằng {{.Params.value}}.",
+	"less_than_equal_to_field":    "{{.Label}} phải nhỏ hơn hoặc bằng {{.Params.key_path}}.",
+	"less_than_field":             "{{.Label}} phải nhỏ hơn {{.Params.key_path}}.",
+	"luhn":                        "{{.Label}} phải vượt qua kiểm tra Luhn.",
+	"map_keys":                    "{{.Label}} phải là một map.",
+	"map_values":                  "{{.Label}} phải là một map.",
+	"matches":                     "{{.Label}} phải khớp với mẫu {{.Params.pattern}}.",
+	"max":                         "{{.Label}} không được lớn hơn {{.Params.max}}.",
+	"max_length":                  "{{.Label}} phải có độ dài tối đa {{.Params.length}}.",
+	"min":                         "{{.Label}} không được nhỏ hơn {{.Params.min}}.",
+	"min_length":                  "{{.Label}} phải có độ dài tối thiểu {{.Params.length}}.",
+	"nan":                         "{{.Label}} phải là NaN.",
+	"natural":                     "{{.Label}} phải là số tự nhiên.",
+	"natural_non_zero":            "{{.Label}} phải là số tự nhiên lớn hơn hoặc bằng 1.",
+	"not":                         "{{.Label}} không được thỏa mãn quy tắc.",
+	"not_allowed":                 "{{.Label}} không được phép.",
+	"not_equals_field":            "{{.Label}} không được bằng {{.Params.key_path}}.",
+	"not_matches":                 "{{.Label}} không được khớp với mẫu {{.Params.pattern}}.",
+	"not_zero":                    "{{.Label}} không được là giá trị mặc định.",
+	"numeric":                     "{{.Label}} phải là một số.",
+	"object":                      "{{.Label}} phải là một đối tượng.",
+	"one_of":                      "{{.Label}} phải thỏa mãn đúng một quy tắc.",
+	"or":                          "{{.Label}} phải thỏa mãn ít nhất một quy tắc.",
+	"plain_object":                "{{.Label}} phải là một map.",
+	"regex":                       "{{.Label}} phải là một biểu thức chính quy.",
+	"required":                    "{{.Label}} là bắt buộc.",
+	"required_if":                 "{{.Label}} là bắt buộc khi {{.Params.key_path}} là {{.Params.value}}.",
+	"required_unless":             "{{.Label}} là bắt buộc trừ khi {{.Params.key_path}} là {{.Params.value}}.",
+	"required_with":               "{{.Label}} là bắt buộc.",
+	"required_without":            "{{.Label}} là bắt buộc.",
+	"size":                        "{{.Label}} phải có kích thước bằng {{.Params.size}}.",
+	"string":                      "{{.Label}} phải là một chuỗi.",
+	"unique":                      "{{.Label}} đã được sử dụng.",
+	"url":                         "{{.Label}} phải là URL hợp lệ.",
+	"uuid":                        "{{.Label}} phải là UUID hợp lệ.",
+	"xor":                         "{{.Label}} phải thỏa mãn đúng một trong hai quy tắc.",
+}
@@ -1,92 +1,194 @@
 package checkit
 
-import "strings"
+import (
+	"context"
+	"sort"
+)
 
 // Validator ...
 type Validator map[string]Validating
 
 // ValidateSync ...
-func ValidateSync(value interface{}, validator Validator) (bool, error) {
-	return validator.ValidateSync(value)
+func ValidateSync(value interface{}, validator Validator, opts ...Option) (bool, error) {
+	return validator.ValidateSync(value, opts...)
 }
 
 // MayBeSync ...
-func MayBeSync(value interface{}, validator Validator) (bool, error) {
-	return validator.MayBeSync(value)
+func MayBeSync(value interface{}, validator Validator, opts ...Option) (bool, error) {
+	return validator.MayBeSync(value, opts...)
+}
+
+// ValidateAll ...
+func ValidateAll(value interface{}, validator Validator, opts ...Option) (bool, error) {
+	return validator.ValidateAll(value, opts...)
 }
 
 // ValidateSync ...
-func (v Validator) ValidateSync(value interface{}) (bool, error) {
-	for keyPath, validating := range v {
-		r, err := validateKeyPathWithValidating(value, keyPath, validating)
-		if err != nil {
-			return false, err
-		}
-		if !r {
-			return false, nil
+func (v Validator) ValidateSync(value interface{}, opts ...Option) (bool, error) {
+	return v.fields().validateSync(value, newOptions(opts))
+}
+
+// MayBeSync ...
+func (v Validator) MayBeSync(value interface{}, opts ...Option) (bool, error) {
+	return v.fields().mayBeSync(value, newOptions(opts))
+}
+
+// ValidateAll evaluates every key path and every rule of a CompoundValidating
+// instead of stopping at the first failure, the error is a ValidationErrors
+func (v Validator) ValidateAll(value interface{}, opts ...Option) (bool, error) {
+	return v.fields().validateAll(value, newOptions(opts))
+}
+
+// fields returns the key paths sorted so the evaluation order does not depend on map iteration
+func (v Validator) fields() fields {
+	var keyPaths = make([]string, 0, len(v))
+	for keyPath := range v {
+		keyPaths = append(keyPaths, keyPath)
+	}
+	sort.Strings(keyPaths)
+	var fs = make(fields, len(keyPaths))
+	for i, keyPath := range keyPaths {
+		fs[i] = field{keyPath: keyPath, validating: v[keyPath]}
+	}
+	return fs
+}
+
+type field struct {
+	keyPath    string
+	validating Validating
+}
+
+type fields []field
+
+func (fs fields) validateSync(value interface{}, o *options) (bool, error) {
+	ctx := withOptions(withRoot(context.Background(), value), o)
+	for _, f := range fs {
+		if errs := validateKeyPathWithValidating(ctx, value, f.keyPath, f.validating, true); len(errs) > 0 {
+			if err := lookupErrorOf(errs); err != nil {
+				return false, err
+			}
+			return false, o.localize(errs[0])
 		}
 	}
 	return true, nil
 }
 
-// MayBeSync ...
-func (v Validator) MayBeSync(value interface{}) (bool, error) {
+func (fs fields) mayBeSync(value interface{}, o *options) (bool, error) {
 	var result bool = false
-	for keyPath, validating := range v {
-		r, err := validateKeyPathWithValidating(value, keyPath, validating)
-		switch e := err.(type) {
-		case *internalError:
-			return false, e
-		default:
-			result = result || r
+	ctx := withOptions(withRoot(context.Background(), value), o)
+	for _, f := range fs {
+		errs := validateKeyPathWithValidating(ctx, value, f.keyPath, f.validating, true)
+		if err := lookupErrorOf(errs); err != nil {
+			return false, err
 		}
+		if err := firstInternalError(errs); err != nil {
+			return false, o.localize(err)
+		}
+		result = result || len(errs) == 0
 	}
 	return result, nil
 }
 
-func validateKeyPathWithValidating(value interface{}, keyPath string, validating Validating) (bool, error) {
-	var keys []string = []string{}
-	for _, k := range strings.Split(keyPath, ".") {
-		if len(k) > 0 {
-			keys = append(keys, k)
-		}
+func (fs fields) validateAll(value interface{}, o *options) (bool, error) {
+	var errs ValidationErrors
+	ctx := withOptions(withRoot(context.Background(), value), o)
+	for _, f := range fs {
+		errs = append(errs, validateKeyPathWithValidating(ctx, value, f.keyPath, f.validating, false)...)
 	}
+	if err := lookupErrorOf(errs); err != nil {
+		return false, err
+	}
+	if len(errs) > 0 {
+		return false, ValidationErrors(o.localizeAll(errs))
+	}
+	return true, nil
+}
+
+func validateKeyPathWithValidating(ctx context.Context, value interface{}, keyPath string, validating Validating, failFast bool) []*FieldError {
+	keys := splitKeyPath(keyPath)
 	if len(keys) == 0 {
-		return validating.Validate(value)
+		return collectFieldErrors(ctx, validating, value, "", failFast)
 	}
 
 	root := makeNormalWrappedKeyedValue(value, nil)
 	buildWrappedKeyValueWithKeys(keys, 0, value, root)
 
-	return root.validateWithValidating(validating)
+	return root.validateWithValidating(ctx, validating, failFast)
 }
 
-func (w *wrappedKeyedValue) validateWithValidating(validating Validating) (bool, error) {
+func (w *wrappedKeyedValue) validateWithValidating(ctx context.Context, validating Validating, failFast bool) []*FieldError {
 	if w.shouldValidateAny {
-		var result bool = false
+		if len(w.children) == 0 {
+			return []*FieldError{&FieldError{
+				KeyPath: w.keyPath,
+				Rule:    CodeAny,
+				Value:   w.value,
+				Message: "The value must have at least one element.",
+			}}
+		}
+		var errs []*FieldError
 		for _, child := range w.children {
-			r, err := child.validateWithValidating(validating)
-			switch e := err.(type) {
-			case *internalError:
-				return false, e
-			default:
-				result = result || r
+			childErrs := child.validateWithValidating(ctx, validating, failFast)
+			if len(childErrs) == 0 {
+				return nil
 			}
+			if firstInternalError(childErrs) != nil {
+				return childErrs
+			}
+			errs = append(errs, childErrs...)
 		}
-		return result, nil
+		return errs
 	}
 	// Children is empty so just validate the value
-	if len(w.children) == 0 {
-		return validating.Validate(w.value)
+	if !w.shouldValidateAll && len(w.children) == 0 {
+		ctx = withScopeKeyPath(ctx, w.keyPath)
+		if w.absent {
+			ctx = withAbsent(ctx)
+		}
+		return collectScopedFieldErrors(ctx, validating, w.value, w.keyPath, failFast)
 	}
+	var errs []*FieldError
 	for _, child := range w.children {
-		r, err := child.validateWithValidating(validating)
-		if err != nil {
-			return false, err
+		errs = append(errs, child.validateWithValidating(ctx, validating, failFast)...)
+		if failFast && len(errs) > 0 {
+			return errs
+		}
+	}
+	return errs
+}
+
+// collectFieldErrors validates a single value, rules of a CompoundValidating are evaluated one by one.
+// The key path is relative to the value of the current scope, it is empty for the value itself.
+func collectFieldErrors(ctx context.Context, validating Validating, value interface{}, keyPath string, failFast bool) []*FieldError {
+	return collectScopedFieldErrors(withScopeKeyPath(ctx, keyPath), validating, value, keyPath, failFast)
+}
+
+func collectScopedFieldErrors(ctx context.Context, validating Validating, value interface{}, keyPath string, failFast bool) []*FieldError {
+	if err := ctx.Err(); err != nil {
+		return []*FieldError{toFieldError(err, value, keyPath)}
+	}
+	if compound, ok := validating.(CompoundValidating); ok {
+		var errs []*FieldError
+		for _, v := range compound {
+			errs = append(errs, collectScopedFieldErrors(ctx, v, value, keyPath, failFast)...)
+			if failFast && len(errs) > 0 {
+				return errs
+			}
 		}
-		if !r {
-			return false, nil
+		return errs
+	}
+	r, err := validateWithContext(ctx, validating, value)
+	if r && err == nil {
+		return nil
+	}
+	return toFieldErrors(err, value, keyPath)
+}
+
+func firstInternalError(errs []*FieldError) *FieldError {
+	for _, err := range errs {
+		if isInternalError(err) {
+			return err
 		}
 	}
-	return true, nil
+	return nil
 }
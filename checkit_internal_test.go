@@ -1,6 +1,7 @@
 package checkit
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -23,3 +24,82 @@ func TestSomething1(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestValidateSync_whenElementFails_shouldReturnFieldErrorWithKeyPath(t *testing.T) {
+	type item struct {
+		price int
+	}
+	_, err := Validator(map[string]Validating{
+		"items.all.price": GreaterThan(0),
+	}).ValidateSync(struct {
+		items []item
+	}{
+		items: []item{item{price: 1}, item{price: 0}},
+	})
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		t.Fatalf("Error must be a *FieldError, got %T", err)
+	}
+	if fieldErr.KeyPath != "items.1.price" {
+		t.Errorf("Key path must be %q, got %q", "items.1.price", fieldErr.KeyPath)
+	}
+	if fieldErr.Rule != "greater_than" {
+		t.Errorf("Rule must be %q, got %q", "greater_than", fieldErr.Rule)
+	}
+	if fieldErr.Params["value"] != 0 {
+		t.Errorf("Param value must be %d", 0)
+	}
+}
+
+func TestValidateSync_whenKeyIsMissing_shouldReturnDeclaredKeyPath(t *testing.T) {
+	_, err := Validator(map[string]Validating{
+		"a.b": ExistsNonNil(),
+	}).ValidateSync(map[string]interface{}{})
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		t.Fatalf("Error must be a *FieldError, got %T", err)
+	}
+	if fieldErr.KeyPath != "a.b" {
+		t.Errorf("Key path must be %q, got %q", "a.b", fieldErr.KeyPath)
+	}
+}
+
+func TestValidateAll_shouldReportEveryFailure(t *testing.T) {
+	_, err := Validator(map[string]Validating{
+		"a": CompoundValidating{GreaterThan(5), LessThan(0)},
+		"b": MaxLength(1),
+		"c": MinLength(1),
+	}).ValidateAll(map[string]interface{}{
+		"a": 1,
+		"b": "ab",
+		"c": "c",
+	})
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Error must be a ValidationErrors, got %T", err)
+	}
+	if len(errs) != 3 {
+		t.Errorf("Error count must be %d, got %d", 3, len(errs))
+	}
+	groups := errs.ByKeyPath()
+	if len(groups["a"]) != 2 || len(groups["b"]) != 1 || len(groups["c"]) != 0 {
+		t.Errorf("Errors must be grouped by key path, got %v", groups)
+	}
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Errorf("Error must unwrap to a *FieldError")
+	}
+}
+
+func TestValidateAll_whenAllElementsFail_shouldReportEachElement(t *testing.T) {
+	_, err := Validator(map[string]Validating{
+		"all": Integer(),
+	}).ValidateAll([]interface{}{"a", 1, "b"})
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Error must be a ValidationErrors, got %T", err)
+	}
+	if len(errs) != 2 || errs[0].KeyPath != "0" || errs[1].KeyPath != "2" {
+		t.Errorf("Errors must point at the failing elements, got %v", errs)
+	}
+}
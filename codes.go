@@ -0,0 +1,82 @@
+package checkit
+
+// Codes of the built-in rules, they are reported by FieldError.Rule and RuleInfo.Code
+// and do not change between releases, clients should switch on them rather than on messages.
+// CodeValueMissing is reported in place of the rule code by localized errors wrapping ErrValueMissing.
+const (
+	CodeAccepted                = "accepted"
+	CodeAllOf                   = "all_of"
+	CodeAllValues               = "all_values"
+	CodeAlpha                   = "alpha"
+	CodeAlphaDash               = "alpha_dash"
+	CodeAlphaNumeric            = "alpha_numeric"
+	CodeAlphaUnderscore         = "alpha_underscore"
+	CodeAny                     = "any"
+	CodeArray                   = "array"
+	CodeAtLeast                 = "at_least"
+	CodeBase64                  = "base64"
+	CodeBetween                 = "between"
+	CodeBoolean                 = "boolean"
+	CodeContains                = "contains"
+	CodeDate                    = "date"
+	CodeEach                    = "each"
+	CodeEmail                   = "email"
+	CodeEmpty                   = "empty"
+	CodeEquals                  = "equals"
+	CodeEqualsField             = "equals_field"
+	CodeExactLength             = "exact_length"
+	CodeExcludedIf              = "excluded_if"
+	CodeExists                  = "exists"
+	CodeExistsNonNil            = "exists_non_nil"
+	CodeFinite                  = "finite"
+	CodeFunction                = "function"
+	CodeGreaterThan             = "greater_than"
+	CodeGreaterThanEqualTo      = "greater_than_equal_to"
+	CodeGreaterThanEqualToField = "greater_than_equal_to_field"
+	CodeGreaterThanField        = "greater_than_field"
+	CodeInteger                 = "integer"
+	CodeIpv4                    = "ipv4"
+	CodeIpv6                    = "ipv6"
+	CodeLessThan                = "less_than"
+	CodeLessThanEqualTo         = "less_than_equal_to"
+	CodeLessThanEqualToField    = "less_than_equal_to_field"
+	CodeLessThanField           = "less_than_field"
+	CodeLuhn                    = "luhn"
+	CodeMapKeys                 = "map_keys"
+	CodeMapValues               = "map_values"
+	CodeMatches                 = "matches"
+	CodeMax                     = "max"
+	CodeMaxLength               = "max_length"
+	CodeMin                     = "min"
+	CodeMinLength               = "min_length"
+	CodeNaN                     = "nan"
+	CodeNatural                 = "natural"
+	CodeNaturalNonZero          = "natural_non_zero"
+	CodeNot                     = "not"
+	CodeNotEqualsField          = "not_equals_field"
+	CodeNotAllowed              = "not_allowed"
+	CodeNotMatches              = "not_matches"
+	CodeNotZero                 = "not_zero"
+	CodeNullable                = "nullable"
+	CodeNumeric                 = "numeric"
+	CodeObject                  = "object"
+	CodeOneOf                   = "one_of"
+	CodeOptional                = "optional"
+	CodeOr                      = "or"
+	CodePlainObject             = "plain_object"
+	CodeRef                     = "ref"
+	CodeRegex                   = "regex"
+	CodeRequired                = "required"
+	CodeRequiredIf              = "required_if"
+	CodeRequiredUnless          = "required_unless"
+	CodeRequiredWith            = "required_with"
+	CodeRequiredWithout         = "required_without"
+	CodeSize                    = "size"
+	CodeString                  = "string"
+	CodeURL                     = "url"
+	CodeUUID                    = "uuid"
+	CodeUnique                  = "unique"
+	CodeValueMissing            = "value_missing"
+	CodeWhen                    = "when"
+	CodeXOr                     = "xor"
+)
@@ -0,0 +1,163 @@
+package checkit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Each validates every element of a slice, an array, a map or a channel,
+// errors are reported under the index or the key of the failing element, map keys are sorted.
+// Each drains the buffered elements of a channel to validate them and sends them back in the same order,
+// the channel must not be used concurrently during the validation, an element which cannot be sent back is lost.
+// A nil value fails with ErrValueMissing.
+func Each(validating Validating) Validating {
+	return &collectionValidator{
+		validating: validating,
+		rule:       CodeEach,
+		elements:   elementsOf,
+	}
+}
+
+// MapKeys validates every key of a map, errors are reported under the failing key
+func MapKeys(validating Validating) Validating {
+	return &collectionValidator{
+		validating: validating,
+		rule:       CodeMapKeys,
+		elements: func(value interface{}) ([]keyedElement, error) {
+			return mapElementsOf(value, true)
+		},
+	}
+}
+
+// MapValues validates every value of a map, errors are reported under the key of the failing value
+func MapValues(validating Validating) Validating {
+	return &collectionValidator{
+		validating: validating,
+		rule:       CodeMapValues,
+		elements: func(value interface{}) ([]keyedElement, error) {
+			return mapElementsOf(value, false)
+		},
+	}
+}
+
+type keyedElement struct {
+	key   string
+	value interface{}
+}
+
+type collectionValidator struct {
+	validating Validating
+	rule       string
+	elements   func(value interface{}) ([]keyedElement, error)
+}
+
+func (v *collectionValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *collectionValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	elements, err := v.elements(value)
+	if err != nil {
+		return false, &FieldError{
+			Rule:    v.rule,
+			Value:   value,
+			Message: err.Error(),
+			Err:     err,
+		}
+	}
+	ctx = ensureScope(ctx, value)
+	var errs ValidationErrors
+	for _, element := range elements {
+		errs = append(errs, collectFieldErrors(ctx, v.validating, element.value, element.key, false)...)
+	}
+	if len(errs) > 0 {
+		return false, errs
+	}
+	return true, nil
+}
+
+func elementsOf(value interface{}) ([]keyedElement, error) {
+	if isMissing(value) {
+		return nil, ErrValueMissing
+	}
+	val := flattenReflectValue(reflect.ValueOf(value))
+	switch val.Kind() {
+	case reflect.Array, reflect.Slice:
+		var elements = make([]keyedElement, val.Len())
+		for i := range elements {
+			elements[i] = keyedElement{key: strconv.Itoa(i), value: getReferenceValue(val.Index(i))}
+		}
+		return elements, nil
+	case reflect.Map:
+		return mapElementsOf(value, false)
+	case reflect.Chan:
+		return chanElementsOf(val)
+	default:
+		return nil, newInternalError("The value must be an array, a slice, a map or a channel")
+	}
+}
+
+// mapElementsOf returns the keys or the values of a map, sorted by key
+func mapElementsOf(value interface{}, keys bool) ([]keyedElement, error) {
+	if isMissing(value) {
+		return nil, ErrValueMissing
+	}
+	val := flattenReflectValue(reflect.ValueOf(value))
+	if val.Kind() != reflect.Map {
+		return nil, newInternalError("The value must be a map")
+	}
+	mapKeys := val.MapKeys()
+	sort.Slice(mapKeys, func(i, j int) bool {
+		return lessMapKey(mapKeys[i], mapKeys[j])
+	})
+	var elements = make([]keyedElement, 0, len(mapKeys))
+	for _, mapKey := range mapKeys {
+		element := keyedElement{key: fmt.Sprint(getReferenceValue(mapKey))}
+		if keys {
+			element.value = getReferenceValue(mapKey)
+		} else {
+			element.value = getReferenceValue(val.MapIndex(mapKey))
+		}
+		elements = append(elements, element)
+	}
+	return elements, nil
+}
+
+// lessMapKey orders numeric keys by value and the other keys by their text
+func lessMapKey(a, b reflect.Value) bool {
+	a, b = flattenReflectValue(a), flattenReflectValue(b)
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		}
+	}
+	return fmt.Sprint(getReferenceValue(a)) < fmt.Sprint(getReferenceValue(b))
+}
+
+func chanElementsOf(val reflect.Value) ([]keyedElement, error) {
+	if val.Type().ChanDir() != reflect.BothDir {
+		return nil, newInternalError("The channel must be bidirectional")
+	}
+	var received []reflect.Value
+	for i, n := 0, val.Len(); i < n; i++ {
+		el, ok := val.TryRecv()
+		if !ok {
+			break
+		}
+		received = append(received, el)
+	}
+	var elements = make([]keyedElement, len(received))
+	for i, el := range received {
+		val.TrySend(el)
+		elements[i] = keyedElement{key: strconv.Itoa(i), value: getReferenceValue(el)}
+	}
+	return elements, nil
+}
@@ -0,0 +1,84 @@
+package checkit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEach(t *testing.T) {
+	_, err := Each(Min(2)).Validate([]int{3, 1, 2, 0})
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 || errs[0].KeyPath != "1" || errs[1].KeyPath != "3" {
+		t.Errorf("Errors must point at the failing indexes, got %v", err)
+	}
+	if _, err := Each(Min(2)).Validate(1); err == nil {
+		t.Errorf("A number must not be iterable")
+	}
+}
+
+func TestEach_whenNested_shouldJoinKeyPaths(t *testing.T) {
+	value := map[string]interface{}{
+		"matrix": [][]int{{1, 2}, {3, -1}},
+	}
+	_, err := Validator{
+		"matrix": Each(Each(Min(0))),
+	}.ValidateAll(value)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].KeyPath != "matrix.1.1" {
+		t.Errorf("Error must point at %q, got %v", "matrix.1.1", err)
+	}
+}
+
+func TestEach_whenChannel_shouldKeepElements(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- -1
+	_, err := Each(Min(0)).Validate(ch)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].KeyPath != "1" {
+		t.Errorf("Error must point at the second element, got %v", err)
+	}
+	if len(ch) != 2 || <-ch != 1 || <-ch != -1 {
+		t.Errorf("Channel elements must be kept in order")
+	}
+}
+
+func TestMapKeysAndValues(t *testing.T) {
+	value := map[string]int{"ok": 1, "not ok": -1}
+	_, err := MapKeys(AlphaNumeric()).Validate(value)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].KeyPath != "not ok" || errs[0].Value != "not ok" {
+		t.Errorf("Error must point at the failing key, got %v", err)
+	}
+	_, err = Validator{"scores": MapValues(Min(0))}.ValidateAll(map[string]interface{}{"scores": value})
+	var keyPaths []string
+	for _, fieldErr := range err.(ValidationErrors) {
+		keyPaths = append(keyPaths, fieldErr.KeyPath)
+	}
+	if !reflect.DeepEqual(keyPaths, []string{"scores.not ok"}) {
+		t.Errorf("Error must point at the key of the failing value, got %v", keyPaths)
+	}
+}
+
+func TestEach_whenValueIsMissing_shouldReturnErrValueMissing(t *testing.T) {
+	for _, validating := range []Validating{Each(Min(0)), MapKeys(Min(0)), MapValues(Min(0))} {
+		if _, err := validating.Validate(nil); !errors.Is(err, ErrValueMissing) {
+			t.Errorf("A nil value must be reported as missing, got %v", err)
+		}
+	}
+	if r, err := Each(Min(0)).Validate([]int(nil)); !r {
+		t.Errorf("A nil slice must have no element to validate, got %v", err)
+	}
+}
+
+func TestMapValues_shouldSortNumericKeysByValue(t *testing.T) {
+	_, err := MapValues(Min(0)).Validate(map[int]int{10: -1, 9: -1, 2: -1})
+	var keyPaths []string
+	for _, fieldErr := range err.(ValidationErrors) {
+		keyPaths = append(keyPaths, fieldErr.KeyPath)
+	}
+	if !reflect.DeepEqual(keyPaths, []string{"2", "9", "10"}) {
+		t.Errorf("Numeric keys must be sorted by value, got %v", keyPaths)
+	}
+}
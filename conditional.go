@@ -0,0 +1,116 @@
+package checkit
+
+import (
+	"context"
+)
+
+// When validates the value with then when the value at keyPath passes the predicate, with otherwise if not.
+// A nil rule passes. keyPath starts from the root of the validated value,
+// or from the parent of the value under validation when it starts with a dot, e.g. ".type".
+//
+//	"memo": When(".type", Equals("transfer"), MinLength(1), nil)
+func When(keyPath string, predicate Validating, then Validating, otherwise Validating) Validating {
+	return &conditionalValidator{
+		keyPath:   keyPath,
+		predicate: predicate,
+		then:      then,
+		otherwise: otherwise,
+	}
+}
+
+// RequiredIf requires the value when the value at keyPath equals v
+func RequiredIf(keyPath string, v interface{}) Validating {
+	return conditionalRequired(CodeRequiredIf, "The value is required.", map[string]interface{}{"key_path": keyPath, "value": v}, func(scope *validationScope) bool {
+		return isEqual(scope.resolve(keyPath), v)
+	})
+}
+
+// RequiredUnless requires the value unless the value at keyPath equals v
+func RequiredUnless(keyPath string, v interface{}) Validating {
+	return conditionalRequired(CodeRequiredUnless, "The value is required.", map[string]interface{}{"key_path": keyPath, "value": v}, func(scope *validationScope) bool {
+		return !isEqual(scope.resolve(keyPath), v)
+	})
+}
+
+// RequiredWith requires the value when any of the values at keyPaths is present
+func RequiredWith(keyPaths ...string) Validating {
+	return conditionalRequired(CodeRequiredWith, "The value is required.", map[string]interface{}{"key_paths": keyPaths}, func(scope *validationScope) bool {
+		for _, keyPath := range keyPaths {
+			if scope.resolve(keyPath) != nil {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// RequiredWithout requires the value when any of the values at keyPaths is missing
+func RequiredWithout(keyPaths ...string) Validating {
+	return conditionalRequired(CodeRequiredWithout, "The value is required.", map[string]interface{}{"key_paths": keyPaths}, func(scope *validationScope) bool {
+		for _, keyPath := range keyPaths {
+			if scope.resolve(keyPath) == nil {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ExcludedIf requires the value to be missing when the value at keyPath equals v
+func ExcludedIf(keyPath string, v interface{}) Validating {
+	return &contextValidator{
+		validator: validator{
+			errorMessage: "The value must not be present.",
+			rule:         CodeExcludedIf,
+			params:       map[string]interface{}{"key_path": keyPath, "value": v},
+		},
+		validateContextFunc: func(ctx context.Context, value interface{}) (bool, error) {
+			if !isEqual(scopeOf(ctx, value).resolve(keyPath), v) {
+				return true, nil
+			}
+			return isNil(value), nil
+		},
+	}
+}
+
+func conditionalRequired(rule string, errorMessage string, params map[string]interface{}, condition func(*validationScope) bool) Validating {
+	return &contextValidator{
+		validator: validator{
+			errorMessage: errorMessage,
+			rule:         rule,
+			params:       params,
+		},
+		validateContextFunc: func(ctx context.Context, value interface{}) (bool, error) {
+			if !condition(scopeOf(ctx, value)) {
+				return true, nil
+			}
+			return !isNil(value), nil
+		},
+	}
+}
+
+type conditionalValidator struct {
+	keyPath   string
+	predicate Validating
+	then      Validating
+	otherwise Validating
+}
+
+func (v *conditionalValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *conditionalValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	other := scopeOf(ctx, value).resolve(v.keyPath)
+	var validating = v.otherwise
+	if r, err := validateWithContext(ctx, v.predicate, other); r && err == nil {
+		validating = v.then
+	}
+	if validating == nil {
+		return true, nil
+	}
+	if errs := collectFieldErrors(ctx, validating, value, "", false); len(errs) > 0 {
+		return false, ValidationErrors(errs)
+	}
+	return true, nil
+}
@@ -0,0 +1,106 @@
+package checkit
+
+import (
+	"testing"
+)
+
+func TestRequiredIf(t *testing.T) {
+	validator := Validator{
+		"memo": RequiredIf("type", "transfer"),
+	}
+	if r, err := validator.ValidateSync(map[string]interface{}{"type": "deposit"}); !r {
+		t.Errorf("Memo must be optional, got %v", err)
+	}
+	_, err := validator.ValidateSync(map[string]interface{}{"type": "transfer"})
+	if fieldErr, ok := err.(*FieldError); !ok || fieldErr.Rule != "required_if" || fieldErr.KeyPath != "memo" {
+		t.Errorf("Memo must be required, got %v", err)
+	}
+}
+
+func TestRequiredIf_whenKeyPathIsRelative_shouldResolveSiblings(t *testing.T) {
+	value := map[string]interface{}{
+		"transactions": []map[string]interface{}{
+			{"type": "transfer", "memo": "rent"},
+			{"type": "deposit"},
+			{"type": "transfer"},
+		},
+	}
+	_, err := Validator{
+		"transactions.all.memo": RequiredIf(".type", "transfer"),
+	}.ValidateAll(value)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].KeyPath != "transactions.2.memo" {
+		t.Errorf("Only the third memo must be required, got %v", err)
+	}
+	_, err = Validator{
+		"transactions": Each(MapValues(Equals("transfer"))),
+	}.ValidateAll(map[string]interface{}{"transactions": []map[string]interface{}{{"type": "deposit"}}})
+	if errs, ok := err.(ValidationErrors); !ok || errs[0].KeyPath != "transactions.0.type" {
+		t.Errorf("Scope must follow nested rules, got %v", err)
+	}
+}
+
+func TestRequiredUnless(t *testing.T) {
+	validating := RequiredUnless("country", "VN")
+	if r, _ := validating.Validate(map[string]interface{}{"country": "VN"}); !r {
+		t.Fail()
+	}
+	_, err := Validator{"zip": validating}.ValidateSync(map[string]interface{}{"country": "US"})
+	if err == nil {
+		t.Errorf("Zip must be required")
+	}
+}
+
+func TestRequiredWithAndWithout(t *testing.T) {
+	value := map[string]interface{}{"first": "a"}
+	if _, err := (Validator{"last": RequiredWith("first")}).ValidateSync(value); err == nil {
+		t.Errorf("Last must be required with first")
+	}
+	if _, err := (Validator{"email": RequiredWithout("phone")}).ValidateSync(value); err == nil {
+		t.Errorf("Email must be required without phone")
+	}
+	if r, err := (Validator{"email": RequiredWithout("first")}).ValidateSync(value); !r {
+		t.Errorf("Email must be optional with first, got %v", err)
+	}
+}
+
+func TestExcludedIf(t *testing.T) {
+	validator := Validator{"fee": ExcludedIf("type", "internal")}
+	if _, err := validator.ValidateSync(map[string]interface{}{"type": "internal", "fee": 1}); err == nil {
+		t.Errorf("Fee must be excluded")
+	}
+	if r, _ := validator.ValidateSync(map[string]interface{}{"type": "external", "fee": 1}); !r {
+		t.Fail()
+	}
+	for _, fee := range []interface{}{(*int)(nil), []int(nil), map[string]int(nil)} {
+		if r, err := validator.ValidateSync(map[string]interface{}{"type": "internal", "fee": fee}); !r {
+			t.Errorf("A nil %T must count as missing, got %v", fee, err)
+		}
+	}
+}
+
+func TestWhen(t *testing.T) {
+	validator := Validator{
+		"amount": When("currency", Equals("VND"), Natural(), Min(0.01)),
+	}
+	if r, _ := validator.ValidateSync(map[string]interface{}{"currency": "VND", "amount": 1000}); !r {
+		t.Fail()
+	}
+	_, err := validator.ValidateSync(map[string]interface{}{"currency": "USD", "amount": 0.001})
+	if fieldErr, ok := err.(*FieldError); !ok || fieldErr.Rule != "min" || fieldErr.KeyPath != "amount" {
+		t.Errorf("Otherwise rule must fail, got %v", err)
+	}
+}
+
+func TestConditionalRules_fromStructTags(t *testing.T) {
+	type transaction struct {
+		Type string
+		Memo interface{} `checkit:"requiredIf=Type:transfer"`
+	}
+	if _, err := ValidateStruct(transaction{Type: "transfer"}); err == nil {
+		t.Errorf("Memo must be required")
+	}
+	if _, err := Parse("requiredWith:a:b|excludedIf:c:1"); err != nil {
+		t.Error(err)
+	}
+}
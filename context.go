@@ -0,0 +1,118 @@
+package checkit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// ValidatingContext is implemented by rules which need a context, e.g. to do I/O.
+// Validator.Validate passes its context, the other entry points pass context.Background().
+type ValidatingContext interface {
+	Validating
+	ValidateContext(ctx context.Context, value interface{}) (bool, error)
+}
+
+// Validate evaluates every key path of the validator concurrently
+func Validate(ctx context.Context, value interface{}, validator Validator, opts ...Option) (bool, error) {
+	return validator.Validate(ctx, value, opts...)
+}
+
+// Validate evaluates key paths concurrently with at most WithConcurrency workers,
+// the errors are reported as a ValidationErrors in the same order as ValidateAll.
+// Lookups of Unique and Exists are shared by the key paths, see WithLookupCache.
+// It returns the context error when the context is done before every key path is evaluated.
+func (v Validator) Validate(ctx context.Context, value interface{}, opts ...Option) (bool, error) {
+	return v.fields().validate(ctx, value, newOptions(opts))
+}
+
+// Validate ...
+func (o *OrderedValidator) Validate(ctx context.Context, value interface{}, opts ...Option) (bool, error) {
+	return o.fields.validate(ctx, value, newOptions(opts))
+}
+
+// ValidateContext ...
+func (c CompoundValidating) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	for _, v := range c {
+		_r, err := validateWithContext(ctx, v, value)
+		if err != nil {
+			return false, err
+		}
+		if !_r {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (fs fields) validate(ctx context.Context, value interface{}, o *options) (bool, error) {
+	ctx = withOptions(withRoot(WithLookupCache(ctx), value), o)
+	var results = make([][]*FieldError, len(fs))
+	var workers = make(chan struct{}, o.concurrency)
+	var wg sync.WaitGroup
+loop:
+	for i, f := range fs {
+		select {
+		case workers <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		wg.Add(1)
+		go func(i int, f field) {
+			defer wg.Done()
+			defer func() { <-workers }()
+			results[i] = validateKeyPathWithValidating(ctx, value, f.keyPath, f.validating, false)
+		}(i, f)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	var errs ValidationErrors
+	for _, result := range results {
+		errs = append(errs, result...)
+	}
+	if err := lookupErrorOf(errs); err != nil {
+		return false, err
+	}
+	if len(errs) > 0 {
+		return false, ValidationErrors(o.localizeAll(errs))
+	}
+	return true, nil
+}
+
+// validateWithContext turns a panic of the rule into a PanicError
+func validateWithContext(ctx context.Context, validating Validating, value interface{}) (result bool, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result, err = false, &PanicError{Recovered: recovered}
+		}
+	}()
+	switch v := value.(type) {
+	case multiValue:
+		value = v.valueFor(validating)
+	case json.Number:
+		value = numberOf(v)
+	}
+	if v, ok := validating.(ValidatingContext); ok {
+		return v.ValidateContext(ctx, value)
+	}
+	return validating.Validate(value)
+}
+
+type contextValidateFunc func(context.Context, interface{}) (bool, error)
+
+// contextValidator is a validator whose rule receives the context
+type contextValidator struct {
+	validator
+	validateContextFunc contextValidateFunc
+}
+
+func (v *contextValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *contextValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	result, err := v.validateContextFunc(ctx, value)
+	return v.result(value, result, err)
+}
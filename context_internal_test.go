@@ -0,0 +1,84 @@
+package checkit
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type contextValidatingFunc func(ctx context.Context, value interface{}) (bool, error)
+
+func (f contextValidatingFunc) Validate(value interface{}) (bool, error) {
+	return f(context.Background(), value)
+}
+
+func (f contextValidatingFunc) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	return f(ctx, value)
+}
+
+func TestValidate_shouldBoundConcurrency(t *testing.T) {
+	var active, maxActive int32
+	rule := contextValidatingFunc(func(ctx context.Context, value interface{}) (bool, error) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			m := atomic.LoadInt32(&maxActive)
+			if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		return true, nil
+	})
+	validator := Validator{"a": rule, "b": rule, "c": rule, "d": rule, "e": rule}
+	r, err := validator.Validate(context.Background(), map[string]int{}, WithConcurrency(2))
+	if !r || err != nil {
+		t.Fatalf("Value must be valid, got %v", err)
+	}
+	if maxActive > 2 {
+		t.Errorf("At most %d key paths must be evaluated at the same time, got %d", 2, maxActive)
+	}
+}
+
+func TestValidate_shouldAggregateInOrder(t *testing.T) {
+	_, err := Schema().
+		Field("b", MinLength(1)).
+		Field("a", MinLength(1)).
+		Validate(context.Background(), map[string]string{"a": "", "b": ""})
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 || errs[0].KeyPath != "b" || errs[1].KeyPath != "a" {
+		t.Errorf("Errors must follow declaration order, got %v", err)
+	}
+}
+
+func TestValidate_whenDeadlineExceeded_shouldReturnContextError(t *testing.T) {
+	rule := contextValidatingFunc(func(ctx context.Context, value interface{}) (bool, error) {
+		<-ctx.Done()
+		return false, ctx.Err()
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := Validator{"a": rule}.Validate(ctx, map[string]int{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Error must be the context error, got %v", err)
+	}
+}
+
+func TestValidate_whenCanceled_shouldNotEvaluateRules(t *testing.T) {
+	var calls int32
+	rule := contextValidatingFunc(func(ctx context.Context, value interface{}) (bool, error) {
+		atomic.AddInt32(&calls, 1)
+		return true, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := Validator{"a": rule, "b": rule}.Validate(ctx, map[string]int{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Error must be the context error, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Rules must not be evaluated after cancellation, got %d calls", calls)
+	}
+}
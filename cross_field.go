@@ -0,0 +1,95 @@
+package checkit
+
+import (
+	"context"
+)
+
+// EqualsField requires the value to equal the value at keyPath.
+// keyPath starts from the root of the validated value,
+// or from the parent of the value under validation when it starts with a dot, e.g. ".password".
+func EqualsField(keyPath string) Validating {
+	return fieldComparison(keyPath, CodeEqualsField, "The value must be equal to the value of the other field.", func(value interface{}, other interface{}) (bool, error) {
+		return isEqual(value, other), nil
+	})
+}
+
+// NotEqualsField requires the value to differ from the value at keyPath
+func NotEqualsField(keyPath string) Validating {
+	return fieldComparison(keyPath, CodeNotEqualsField, "The value must not be equal to the value of the other field.", func(value interface{}, other interface{}) (bool, error) {
+		return !isEqual(value, other), nil
+	})
+}
+
+// GreaterThanField requires the value to be greater than the value at keyPath
+func GreaterThanField(keyPath string) Validating {
+	return fieldComparison(keyPath, CodeGreaterThanField, "The value under validation must be \"greater than\" the value of the other field.", func(value interface{}, other interface{}) (bool, error) {
+		lessThanEqualTo, err := lessThanEqualTo(value, other)
+		if err != nil {
+			return false, err
+		}
+		return !lessThanEqualTo, nil
+	})
+}
+
+// GreaterThanEqualToField requires the value to be greater than or equal to the value at keyPath
+func GreaterThanEqualToField(keyPath string) Validating {
+	return fieldComparison(keyPath, CodeGreaterThanEqualToField, "The value under validation must be \"greater than\" or \"equal to\" the value of the other field.", greatThanEqualTo)
+}
+
+// LessThanField requires the value to be less than the value at keyPath
+func LessThanField(keyPath string) Validating {
+	return fieldComparison(keyPath, CodeLessThanField, "The value under validation must be \"less than\" the value of the other field.", func(value interface{}, other interface{}) (bool, error) {
+		greatThanEqualTo, err := greatThanEqualTo(value, other)
+		if err != nil {
+			return false, err
+		}
+		return !greatThanEqualTo, nil
+	})
+}
+
+// LessThanEqualToField requires the value to be less than or equal to the value at keyPath
+func LessThanEqualToField(keyPath string) Validating {
+	return fieldComparison(keyPath, CodeLessThanEqualToField, "The value under validation must be \"less than\" or \"equal to\" the value of the other field.", lessThanEqualTo)
+}
+
+type compareFunc func(value interface{}, other interface{}) (bool, error)
+
+// fieldComparisonValidator reports the key path and the value of the other field in the error params
+type fieldComparisonValidator struct {
+	keyPath      string
+	compare      compareFunc
+	rule         string
+	errorMessage string
+}
+
+func fieldComparison(keyPath string, rule string, errorMessage string, compare compareFunc) Validating {
+	return &fieldComparisonValidator{
+		keyPath:      keyPath,
+		compare:      compare,
+		rule:         rule,
+		errorMessage: errorMessage,
+	}
+}
+
+func (v *fieldComparisonValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *fieldComparisonValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	other := scopeOf(ctx, value).resolve(v.keyPath)
+	result, err := v.compare(value, other)
+	if result && err == nil {
+		return true, nil
+	}
+	fieldErr := &FieldError{
+		Rule:    v.rule,
+		Params:  map[string]interface{}{"key_path": v.keyPath, "other": other},
+		Value:   value,
+		Message: v.errorMessage,
+	}
+	if err != nil {
+		fieldErr.Message = err.Error()
+		fieldErr.Err = err
+	}
+	return false, fieldErr
+}
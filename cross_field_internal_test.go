@@ -0,0 +1,56 @@
+package checkit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGreaterThanField(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	validator := Validator{
+		"end_time": GreaterThanField("start_time"),
+	}
+	if r, _ := validator.ValidateSync(map[string]interface{}{"start_time": start, "end_time": start.Add(time.Hour)}); !r {
+		t.Fail()
+	}
+	_, err := validator.ValidateSync(map[string]interface{}{"start_time": start, "end_time": start})
+	fieldErr, ok := err.(*FieldError)
+	if !ok || fieldErr.Params["other"] != start || fieldErr.Value != start || fieldErr.Params["key_path"] != "start_time" {
+		t.Errorf("Error must report both values, got %v", err)
+	}
+}
+
+func TestEqualsField(t *testing.T) {
+	type form struct {
+		Password        string
+		PasswordConfirm string `checkit:"equalsField=.Password"`
+	}
+	if r, err := ValidateStruct(form{Password: "a", PasswordConfirm: "a"}); !r {
+		t.Errorf("Passwords must match, got %v", err)
+	}
+	if _, err := ValidateStruct(form{Password: "a", PasswordConfirm: "b"}); err == nil {
+		t.Errorf("Passwords must not match")
+	}
+}
+
+func TestComparisonFields_whenRelative_shouldResolveSiblings(t *testing.T) {
+	value := map[string]interface{}{
+		"ranges": []map[string]int{{"min": 1, "max": 2}, {"min": 3, "max": 3}, {"min": 5, "max": 4}},
+	}
+	_, err := Validator{
+		"ranges.all.max": CompoundValidating{GreaterThanEqualToField(".min"), NotEqualsField(".min")},
+		"ranges.all.min": LessThanEqualToField(".max"),
+	}.ValidateAll(value)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 3 {
+		t.Fatalf("Three errors must be reported, got %v", err)
+	}
+	if errs[0].KeyPath != "ranges.1.max" || errs[0].Rule != "not_equals_field" ||
+		errs[1].KeyPath != "ranges.2.max" || errs[1].Rule != "greater_than_equal_to_field" ||
+		errs[2].KeyPath != "ranges.2.min" || errs[2].Rule != "less_than_equal_to_field" {
+		t.Errorf("Errors must point at the failing ranges, got %v", errs)
+	}
+	if r, _ := LessThanField("b").Validate(map[string]interface{}{"b": 1}); r {
+		t.Errorf("A map must not be comparable")
+	}
+}
@@ -0,0 +1,89 @@
+package checkit
+
+// RuleInfo describes a rule, e.g. for clients rendering their own messages or for exporting schemas.
+// Rules holds the descriptions of the rules combined by rules such as Or, Each or Optional,
+// a rule which does not implement Describing is described by a RuleInfo without a code.
+type RuleInfo struct {
+	Code   string
+	Params map[string]interface{}
+	Rules  []RuleInfo
+}
+
+// Describing is implemented by the built-in rules
+type Describing interface {
+	Describe() RuleInfo
+}
+
+// Describe returns the description of a rule, ok is false when the rule does not implement Describing
+func Describe(validating Validating) (info RuleInfo, ok bool) {
+	describing, ok := validating.(Describing)
+	if !ok {
+		return RuleInfo{}, false
+	}
+	return describing.Describe(), true
+}
+
+func describeAll(validatings []Validating) []RuleInfo {
+	var infos = make([]RuleInfo, len(validatings))
+	for i, validating := range validatings {
+		infos[i], _ = Describe(validating)
+	}
+	return infos
+}
+
+// Describe ...
+func (c CompoundValidating) Describe() RuleInfo {
+	return RuleInfo{Code: CodeAllOf, Rules: describeAll(c)}
+}
+
+func (v *validator) Describe() RuleInfo {
+	return RuleInfo{Code: v.rule, Params: v.params}
+}
+
+func (v *fieldComparisonValidator) Describe() RuleInfo {
+	return RuleInfo{Code: v.rule, Params: map[string]interface{}{"key_path": v.keyPath}}
+}
+
+func (v *collectionValidator) Describe() RuleInfo {
+	return RuleInfo{Code: v.rule, Rules: describeAll([]Validating{v.validating})}
+}
+
+func (v *logicalValidator) Describe() RuleInfo {
+	return RuleInfo{Code: v.rule, Params: v.params, Rules: describeAll(v.branches)}
+}
+
+// Describe lists the predicate, then the rule applied when it passes and the one applied otherwise
+func (v *conditionalValidator) Describe() RuleInfo {
+	return RuleInfo{
+		Code:   CodeWhen,
+		Params: map[string]interface{}{"key_path": v.keyPath},
+		Rules:  describeAll([]Validating{v.predicate, v.then, v.otherwise}),
+	}
+}
+
+// Describe reports Optional or Nullable with the wrapped rule
+func (v *presenceValidator) Describe() RuleInfo {
+	var code = CodeNullable
+	if v.allowAbsent {
+		code = CodeOptional
+	}
+	var info = RuleInfo{Code: code}
+	if v.validating != nil {
+		info.Rules = describeAll([]Validating{v.validating})
+	}
+	return info
+}
+
+func (v *allValuesValidator) Describe() RuleInfo {
+	return RuleInfo{Code: CodeAllValues, Rules: describeAll([]Validating{v.validating})}
+}
+
+func (v *numberValidator) Describe() RuleInfo {
+	return RuleInfo{Code: CodeNumeric, Rules: describeAll([]Validating{v.validating})}
+}
+
+// Describe returns the description of the rule, the message is not part of it
+func (v *messageValidator) Describe() RuleInfo {
+	info, _ := Describe(v.validating)
+	return info
+}
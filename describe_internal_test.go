@@ -0,0 +1,62 @@
+package checkit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	validating, err := Parse("optional|string|maxLength:255|between:1:10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, ok := Describe(validating)
+	expected := RuleInfo{
+		Code: CodeOptional,
+		Rules: []RuleInfo{{
+			Code: CodeAllOf,
+			Rules: []RuleInfo{
+				{Code: CodeString},
+				{Code: CodeMaxLength, Params: map[string]interface{}{"length": 255}},
+				{Code: CodeBetween, Params: map[string]interface{}{"min": 1, "max": 10}},
+			},
+		}},
+	}
+	if !ok || !reflect.DeepEqual(info, expected) {
+		t.Errorf("Expected %v, got %v", expected, info)
+	}
+}
+
+func TestDescribe_combinators(t *testing.T) {
+	info, _ := Describe(WithMessage(Each(Or(Email(), GreaterThanField(".min"), panickingRule{})), "{{.Label}}"))
+	expected := RuleInfo{
+		Code: CodeEach,
+		Rules: []RuleInfo{{
+			Code: CodeOr,
+			Rules: []RuleInfo{
+				{Code: CodeEmail},
+				{Code: CodeGreaterThanField, Params: map[string]interface{}{"key_path": ".min"}},
+				{},
+			},
+		}},
+	}
+	if !reflect.DeepEqual(info, expected) {
+		t.Errorf("Expected %v, got %v", expected, info)
+	}
+	if _, ok := Describe(panickingRule{}); ok {
+		t.Errorf("A rule which does not implement Describing must not be described")
+	}
+	info, _ = Describe(When("type", Equals("a"), Required(), nil))
+	if info.Code != CodeWhen || len(info.Rules) != 3 || info.Rules[1].Code != CodeRequired || info.Rules[2].Code != "" {
+		t.Errorf("Unexpected description %v", info)
+	}
+}
+
+func TestDescribe_everyRegisteredRule(t *testing.T) {
+	for name, validating := range fuzzRules(t) {
+		info, ok := Describe(validating)
+		if !ok || len(info.Code) == 0 {
+			t.Errorf("Rule %q must be described", name)
+		}
+	}
+}
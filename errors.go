@@ -1,5 +1,24 @@
 package checkit
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrValueMissing is wrapped by the errors of rules given a nil value or a nil pointer,
+// e.g. when a key path does not lead to a value
+var ErrValueMissing = errors.New("The value is missing.")
+
+// PanicError reports a rule that panicked, Recovered is the value passed to panic
+type PanicError struct {
+	Recovered interface{}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("The rule panicked: %v", e.Recovered)
+}
+
 type internalError struct {
 	s string
 }
@@ -13,3 +32,144 @@ func newInternalError(s string) error {
 		s: s,
 	}
 }
+
+// LookupError reports a failure of the backend of Unique or Exists, e.g. a lost connection.
+// It is not a validation failure, the entry points return it in place of a ValidationErrors.
+type LookupError struct {
+	Err error
+}
+
+func (e *LookupError) Error() string {
+	return "The lookup failed: " + e.Err.Error()
+}
+
+// Unwrap ...
+func (e *LookupError) Unwrap() error {
+	return e.Err
+}
+
+// isInternalError reports type mismatches, panics and lookup failures, they stop MayBeSync and any
+func isInternalError(err error) bool {
+	var e *internalError
+	var panicErr *PanicError
+	var lookupErr *LookupError
+	return errors.As(err, &e) || errors.As(err, &panicErr) || errors.As(err, &lookupErr)
+}
+
+// lookupErrorOf returns the first LookupError of the failures
+func lookupErrorOf(errs []*FieldError) error {
+	for _, fieldErr := range errs {
+		var lookupErr *LookupError
+		if errors.As(fieldErr, &lookupErr) {
+			return lookupErr
+		}
+	}
+	return nil
+}
+
+// FieldError describes a single rule failure.
+// KeyPath is the resolved key path of the failing value, e.g. "items.3.price",
+// it is empty when the rule was applied directly to a value.
+// Rule is the stable code of the failing rule, e.g. CodeMaxLength, see Describe for the codes of a rule.
+type FieldError struct {
+	KeyPath string
+	Rule    string
+	Params  map[string]interface{}
+	Value   interface{}
+	Message string
+	Err     error
+
+	customMessage bool // set by WithMessage, the message is not localized
+}
+
+func (e *FieldError) Error() string {
+	if len(e.KeyPath) == 0 {
+		return e.Message
+	}
+	return e.KeyPath + ": " + e.Message
+}
+
+// Pointer returns the key path as an RFC 6901 JSON Pointer, e.g. "/items/3/price"
+func (e *FieldError) Pointer() string {
+	return KeyPathToPointer(e.KeyPath)
+}
+
+// Unwrap returns the underlying error, if any
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// withKeyPath prefixes the key path, rules such as Each report key paths relative to their value
+func (e *FieldError) withKeyPath(keyPath string) *FieldError {
+	if len(keyPath) == 0 {
+		return e
+	}
+	fieldErr := *e
+	if len(e.KeyPath) == 0 {
+		fieldErr.KeyPath = keyPath
+	} else {
+		fieldErr.KeyPath = keyPath + "." + e.KeyPath
+	}
+	return &fieldErr
+}
+
+func toFieldErrors(err error, value interface{}, keyPath string) []*FieldError {
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) == 0 {
+		return []*FieldError{toFieldError(err, value, keyPath)}
+	}
+	var fieldErrs = make([]*FieldError, len(errs))
+	for i, fieldErr := range errs {
+		fieldErrs[i] = fieldErr.withKeyPath(keyPath)
+	}
+	return fieldErrs
+}
+
+func toFieldError(err error, value interface{}, keyPath string) *FieldError {
+	switch e := err.(type) {
+	case *FieldError:
+		return e.withKeyPath(keyPath)
+	case nil:
+		return &FieldError{
+			KeyPath: keyPath,
+			Value:   value,
+			Message: "The value is invalid.",
+		}
+	default:
+		return &FieldError{
+			KeyPath: keyPath,
+			Value:   value,
+			Message: e.Error(),
+			Err:     e,
+		}
+	}
+}
+
+// ValidationErrors lists every failure found by ValidateAll
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	var messages = make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap exposes the field errors to errors.Is and errors.As
+func (e ValidationErrors) Unwrap() []error {
+	var errs = make([]error, len(e))
+	for i, fieldErr := range e {
+		errs[i] = fieldErr
+	}
+	return errs
+}
+
+// ByKeyPath groups the field errors by their key path
+func (e ValidationErrors) ByKeyPath() map[string][]*FieldError {
+	var groups = make(map[string][]*FieldError)
+	for _, fieldErr := range e {
+		groups[fieldErr.KeyPath] = append(groups[fieldErr.KeyPath], fieldErr)
+	}
+	return groups
+}
@@ -0,0 +1,192 @@
+package checkit
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+)
+
+// RuleFactory builds a rule from the arguments written after its name in rule strings and struct tags
+type RuleFactory func(args ...interface{}) (Validating, error)
+
+// builtinRules lists the rules every new Registry starts with
+var builtinRules = map[string]RuleFactory{
+	"accepted":                noArgFactory(Accepted),
+	"alpha":                   noArgFactory(Alpha),
+	"alphaDash":               noArgFactory(AlphaDash),
+	"alphaNumeric":            noArgFactory(AlphaNumeric),
+	"alphaUnderscore":         noArgFactory(AlphaUnderscore),
+	"array":                   noArgFactory(Array),
+	"base64":                  noArgFactory(Base64),
+	"between":                 betweenFactory,
+	"boolean":                 noArgFactory(Boolean),
+	"contains":                valueArgFactory(Contains),
+	"date":                    noArgFactory(Date),
+	"email":                   noArgFactory(Email),
+	"empty":                   noArgFactory(Empty),
+	"equals":                  valueArgFactory(Equals),
+	"equalsField":             keyPathArgFactory(EqualsField),
+	"exactLength":             lengthArgFactory(ExactLength),
+	"excludedIf":              keyPathValueArgFactory(ExcludedIf),
+	"existsNonNil":            noArgFactory(ExistsNonNil),
+	"finite":                  noArgFactory(Finite),
+	"function":                noArgFactory(Function),
+	"greaterThan":             valueArgFactory(GreaterThan),
+	"greaterThanEqualTo":      valueArgFactory(GreaterThanEqualTo),
+	"greaterThanEqualToField": keyPathArgFactory(GreaterThanEqualToField),
+	"greaterThanField":        keyPathArgFactory(GreaterThanField),
+	"integer":                 noArgFactory(Integer),
+	"ipv4":                    noArgFactory(Ipv4),
+	"ipv6":                    noArgFactory(Ipv6),
+	"lessThan":                valueArgFactory(LessThan),
+	"lessThanEqualTo":         valueArgFactory(LessThanEqualTo),
+	"lessThanEqualToField":    keyPathArgFactory(LessThanEqualToField),
+	"lessThanField":           keyPathArgFactory(LessThanField),
+	"luhn":                    noArgFactory(Luhn),
+	"matches":                 matchesFactory(MatchesRegexp),
+	"max":                     valueArgFactory(Max),
+	"maxLength":               lengthArgFactory(MaxLength),
+	"min":                     valueArgFactory(Min),
+	"minLength":               lengthArgFactory(MinLength),
+	"natural":                 noArgFactory(Natural),
+	"nan":                     noArgFactory(NaN),
+	"naturalNonZero":          noArgFactory(NaturalNonZero),
+	"notEqualsField":          keyPathArgFactory(NotEqualsField),
+	"notMatches":              matchesFactory(NotMatchesRegexp),
+	"notZero":                 noArgFactory(NotZero),
+	"nullable":                noArgFactory(nullableMarker),
+	"object":                  noArgFactory(Object),
+	"optional":                noArgFactory(optionalMarker),
+	"plainObject":             noArgFactory(PlainObject),
+	"regex":                   noArgFactory(Regex),
+	"required":                noArgFactory(Required),
+	"requiredIf":              keyPathValueArgFactory(RequiredIf),
+	"requiredUnless":          keyPathValueArgFactory(RequiredUnless),
+	"requiredWith":            keyPathsArgFactory(RequiredWith),
+	"requiredWithout":         keyPathsArgFactory(RequiredWithout),
+	"size":                    valueArgFactory(Size),
+	"string":                  noArgFactory(String),
+	"url":                     noArgFactory(URL),
+	"uuid":                    noArgFactory(UUID),
+}
+
+func noArgFactory(constructor func() Validating) RuleFactory {
+	return func(args ...interface{}) (Validating, error) {
+		if err := checkArgCount(args, 0); err != nil {
+			return nil, err
+		}
+		return constructor(), nil
+	}
+}
+
+func valueArgFactory(constructor func(interface{}) Validating) RuleFactory {
+	return func(args ...interface{}) (Validating, error) {
+		if err := checkArgCount(args, 1); err != nil {
+			return nil, err
+		}
+		return constructor(args[0]), nil
+	}
+}
+
+func lengthArgFactory(constructor func(int) Validating) RuleFactory {
+	return func(args ...interface{}) (Validating, error) {
+		if err := checkArgCount(args, 1); err != nil {
+			return nil, err
+		}
+		length, err := intArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return constructor(length), nil
+	}
+}
+
+func keyPathArgFactory(constructor func(string) Validating) RuleFactory {
+	return func(args ...interface{}) (Validating, error) {
+		if err := checkArgCount(args, 1); err != nil {
+			return nil, err
+		}
+		keyPath, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a key path argument, got %v", args[0])
+		}
+		return constructor(keyPath), nil
+	}
+}
+
+func keyPathValueArgFactory(constructor func(string, interface{}) Validating) RuleFactory {
+	return func(args ...interface{}) (Validating, error) {
+		if err := checkArgCount(args, 2); err != nil {
+			return nil, err
+		}
+		keyPath, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a key path argument, got %v", args[0])
+		}
+		return constructor(keyPath, args[1]), nil
+	}
+}
+
+func keyPathsArgFactory(constructor func(...string) Validating) RuleFactory {
+	return func(args ...interface{}) (Validating, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("expected at least 1 argument, got 0")
+		}
+		var keyPaths = make([]string, len(args))
+		for i, arg := range args {
+			keyPath, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected a key path argument, got %v", arg)
+			}
+			keyPaths[i] = keyPath
+		}
+		return constructor(keyPaths...), nil
+	}
+}
+
+func betweenFactory(args ...interface{}) (Validating, error) {
+	if err := checkArgCount(args, 2); err != nil {
+		return nil, err
+	}
+	return Between(args[0], args[1]), nil
+}
+
+// matchesFactory compiles the pattern once, it is read as written, see rawArgRules.
+// Patterns holding ':' or '|' must be quoted in rule strings
+func matchesFactory(constructor func(*regexp.Regexp) Validating) RuleFactory {
+	return func(args ...interface{}) (Validating, error) {
+		if err := checkArgCount(args, 1); err != nil {
+			return nil, err
+		}
+		pattern, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a pattern argument, got %v", args[0])
+		}
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		return constructor(regex), nil
+	}
+}
+
+func checkArgCount(args []interface{}, count int) error {
+	if len(args) != count {
+		return fmt.Errorf("expected %d arguments, got %d", count, len(args))
+	}
+	return nil
+}
+
+func intArg(arg interface{}) (int, error) {
+	switch v := arg.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		if v == math.Trunc(v) {
+			return int(v), nil
+		}
+	}
+	return 0, fmt.Errorf("expected an integer argument, got %v", arg)
+}
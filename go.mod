@@ -0,0 +1,8 @@
+module github.com/dungntm58/checkit
+
+go 1.24.0
+
+require (
+	golang.org/x/text v0.29.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,247 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/dungntm58/checkit"
+)
+
+// decodeValues fills a struct or a map[string]interface{} from query or form values.
+// Struct fields are named by their `form` tag, then by their `json` tag, then by their Go name,
+// slices receive every value of a key and the other fields the first one.
+// The fields of a nested struct are named after the field holding it, e.g. "address.city",
+// while the fields of an embedded struct without tag are named as if they were declared by the outer struct.
+func decodeValues(values map[string][]string, ptr interface{}) error {
+	v := reflect.ValueOf(ptr).Elem()
+	switch v.Kind() {
+	case reflect.Struct:
+		_, err := decodeStruct(values, v, "", map[reflect.Type]bool{})
+		return err
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String || v.Type().Elem().Kind() != reflect.Interface {
+			break
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(values))
+		for key, vs := range values {
+			var value interface{} = vs
+			if len(vs) == 1 {
+				value = vs[0]
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), reflect.ValueOf(value))
+		}
+		v.Set(m)
+		return nil
+	}
+	return fmt.Errorf("cannot decode parameters into %s", v.Type())
+}
+
+// decodeStruct fills the fields named with the prefix, decoded is false when no parameter names one of them.
+// Embedding holds the types embedded on the way to the struct, which share the prefix and could embed each other.
+func decodeStruct(values map[string][]string, v reflect.Value, prefix string, embedding map[reflect.Type]bool) (decoded bool, err error) {
+	t := v.Type()
+	embedding[t] = true
+	defer delete(embedding, t)
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if len(sf.PkgPath) > 0 && !(sf.Anonymous && sf.Type.Kind() == reflect.Struct) {
+			continue
+		}
+		name := fieldName(sf)
+		if name == "-" {
+			continue
+		}
+		vs, ok := values[prefix+name]
+		if structType := structTypeOf(sf.Type); !ok && structType != nil {
+			nestedPrefix, nestedEmbedding := prefix+name+".", map[reflect.Type]bool{}
+			if sf.Anonymous && name == sf.Name {
+				nestedPrefix, nestedEmbedding = prefix, embedding
+			}
+			if nestedEmbedding[structType] || !hasKeyPrefix(values, nestedPrefix) {
+				continue
+			}
+			nestedDecoded, err := decodeNestedStruct(values, v.Field(i), nestedPrefix, nestedEmbedding)
+			if err != nil {
+				return false, err
+			}
+			decoded = decoded || nestedDecoded
+			continue
+		}
+		if !ok || len(vs) == 0 {
+			continue
+		}
+		if err := setField(v.Field(i), vs); err != nil {
+			return false, fmt.Errorf("parameter %q: %w", prefix+name, err)
+		}
+		decoded = true
+	}
+	return decoded, nil
+}
+
+// decodeNestedStruct fills a struct or a pointer to a struct, the pointer is set only when one of its fields is decoded
+func decodeNestedStruct(values map[string][]string, field reflect.Value, prefix string, embedding map[reflect.Type]bool) (bool, error) {
+	if field.Kind() == reflect.Struct {
+		return decodeStruct(values, field, prefix, embedding)
+	}
+	elem := reflect.New(field.Type().Elem())
+	decoded, err := decodeStruct(values, elem.Elem(), prefix, embedding)
+	if decoded && err == nil {
+		field.Set(elem)
+	}
+	return decoded, err
+}
+
+// structTypeOf returns the struct type of a struct or a pointer to a struct, nil for the other types
+func structTypeOf(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+func hasKeyPrefix(values map[string][]string, prefix string) bool {
+	for key := range values {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldName(sf reflect.StructField) string {
+	for _, tagName := range []string{"form", "json"} {
+		if tag, ok := sf.Tag.Lookup(tagName); ok {
+			if name := strings.Split(tag, ",")[0]; len(name) > 0 {
+				return name
+			}
+		}
+	}
+	return sf.Name
+}
+
+// jsonFieldName names struct fields like encoding/json
+func jsonFieldName(sf reflect.StructField) string {
+	if tag, ok := sf.Tag.Lookup("json"); ok {
+		if name := strings.Split(tag, ",")[0]; len(name) > 0 && name != "-" {
+			return name
+		}
+	}
+	return sf.Name
+}
+
+// documentErrors renames the Go field names in the key paths of the failures with the names of the decoded document,
+// so the pointers of the problem address the request rather than the target type
+func documentErrors(err error, t reflect.Type, name func(reflect.StructField) string) error {
+	var errs checkit.ValidationErrors
+	if !errors.As(err, &errs) {
+		var fieldErr *checkit.FieldError
+		if !errors.As(err, &fieldErr) {
+			return err
+		}
+		errs = checkit.ValidationErrors{fieldErr}
+	}
+	var renamed = make(checkit.ValidationErrors, len(errs))
+	for i, fieldErr := range errs {
+		fieldErrCopy := *fieldErr
+		fieldErrCopy.KeyPath = documentKeyPath(t, fieldErr.KeyPath, name)
+		renamed[i] = &fieldErrCopy
+	}
+	return renamed
+}
+
+func documentKeyPath(t reflect.Type, keyPath string, name func(reflect.StructField) string) string {
+	if len(keyPath) == 0 {
+		return keyPath
+	}
+	keys := strings.Split(keyPath, ".")
+	for i, key := range keys {
+		for t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t == nil {
+			break
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			sf, ok := t.FieldByName(key)
+			if !ok {
+				t = nil
+				continue
+			}
+			keys[i], t = name(sf), sf.Type
+		case reflect.Array, reflect.Slice, reflect.Map:
+			t = t.Elem()
+		default:
+			t = nil
+		}
+	}
+	return strings.Join(keys, ".")
+}
+
+func setField(field reflect.Value, vs []string) error {
+	switch field.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(field.Type().Elem())
+		if err := setField(elem.Elem(), vs); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	case reflect.Slice:
+		slice := reflect.MakeSlice(field.Type(), len(vs), len(vs))
+		for i, s := range vs {
+			if err := setScalar(slice.Index(i), s); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	default:
+		return setScalar(field, vs[0])
+	}
+}
+
+func setScalar(field reflect.Value, s string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Interface:
+		if field.NumMethod() > 0 {
+			return fmt.Errorf("cannot decode into %s", field.Type())
+		}
+		field.Set(reflect.ValueOf(s))
+	default:
+		return fmt.Errorf("cannot decode into %s", field.Type())
+	}
+	return nil
+}
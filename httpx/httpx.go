@@ -0,0 +1,212 @@
+// Package httpx decodes and validates request bodies and query parameters with checkit
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/dungntm58/checkit"
+)
+
+// Validator is implemented by checkit.Validator and *checkit.OrderedValidator
+type Validator interface {
+	Validate(ctx context.Context, value interface{}, opts ...checkit.Option) (bool, error)
+}
+
+// Source tells where the value is decoded from
+type Source int
+
+const (
+	// FromRequest decodes the query of GET, HEAD and DELETE requests, the body of the others
+	FromRequest Source = iota
+	// FromBody decodes a JSON, URL encoded or multipart body depending on its Content-Type
+	FromBody
+	// FromQuery decodes the query parameters
+	FromQuery
+)
+
+// Option configures Middleware
+type Option func(*options)
+
+type options struct {
+	source          Source
+	maxBodyBytes    int64
+	validateOptions []checkit.Option
+	problemOptions  []checkit.ProblemOption
+	acceptLanguage  bool
+	disallowUnknown bool
+}
+
+// WithSource sets where the value is decoded from, it defaults to FromRequest
+func WithSource(source Source) Option {
+	return func(o *options) {
+		o.source = source
+	}
+}
+
+// WithMaxBodyBytes bounds the size of the body, it defaults to 1 MiB
+func WithMaxBodyBytes(n int64) Option {
+	return func(o *options) {
+		o.maxBodyBytes = n
+	}
+}
+
+// WithValidateOptions passes options to Validate, e.g. labels
+func WithValidateOptions(opts ...checkit.Option) Option {
+	return func(o *options) {
+		o.validateOptions = append(o.validateOptions, opts...)
+	}
+}
+
+// WithProblemOptions passes options to checkit.WriteProblem, e.g. checkit.AsJSONAPI
+func WithProblemOptions(opts ...checkit.ProblemOption) Option {
+	return func(o *options) {
+		o.problemOptions = append(o.problemOptions, opts...)
+	}
+}
+
+// WithAcceptLanguage localizes messages with the Accept-Language header of the request
+func WithAcceptLanguage() Option {
+	return func(o *options) {
+		o.acceptLanguage = true
+	}
+}
+
+// DisallowUnknownFields rejects JSON bodies holding fields the target does not have
+func DisallowUnknownFields() Option {
+	return func(o *options) {
+		o.disallowUnknown = true
+	}
+}
+
+type valueKey struct{}
+
+// Value returns the validated value placed in the context by Middleware, a pointer to a new value of the target type
+func Value(ctx context.Context) interface{} {
+	return ctx.Value(valueKey{})
+}
+
+// Middleware decodes every request into a new value of the type of target, validates it,
+// and calls the next handler with the pointer to the value in the request context, see Value.
+// A request which cannot be decoded is answered with a 400 problem, 413 for a body over the limit
+// or 415 for an unsupported Content-Type,
+// a value which does not pass the validator with a 422 problem listing the failures,
+// whose pointers name struct fields as the request does: by their `json` tag in JSON bodies, by their `form` tag,
+// then their `json` tag in forms and queries,
+// and any other error of the validator, such as a checkit.LookupError or a checkit.PanicError,
+// with a 500 problem which does not disclose the error.
+//
+//	http.Handle("/orders", httpx.Middleware(CreateOrder{}, validator)(handler))
+func Middleware(target interface{}, validator Validator, opts ...Option) func(http.Handler) http.Handler {
+	targetType := reflect.TypeOf(target)
+	for targetType.Kind() == reflect.Ptr {
+		targetType = targetType.Elem()
+	}
+	var o = &options{
+		maxBodyBytes: 1 << 20,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ptr := reflect.New(targetType)
+			name, err := o.decode(w, r, ptr.Interface())
+			if err != nil {
+				var status = http.StatusBadRequest
+				var maxBytesErr *http.MaxBytesError
+				if errors.Is(err, errUnsupportedMediaType) {
+					status = http.StatusUnsupportedMediaType
+				} else if errors.As(err, &maxBytesErr) {
+					status = http.StatusRequestEntityTooLarge
+				}
+				writeStatusProblem(w, status, err.Error())
+				return
+			}
+			validateOptions := o.validateOptions
+			if o.acceptLanguage {
+				validateOptions = append(validateOptions[:len(validateOptions):len(validateOptions)], checkit.WithAcceptLanguage(r.Header.Get("Accept-Language")))
+			}
+			if _, err := validator.Validate(r.Context(), ptr.Elem().Interface(), validateOptions...); err != nil {
+				if err := checkit.WriteProblem(w, documentErrors(err, targetType, name), o.problemOptions...); err != nil {
+					writeStatusProblem(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+				}
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), valueKey{}, ptr.Interface())))
+		})
+	}
+}
+
+// Handle wraps the handler with Middleware
+func Handle(target interface{}, validator Validator, handler http.Handler, opts ...Option) http.Handler {
+	return Middleware(target, validator, opts...)(handler)
+}
+
+var errUnsupportedMediaType = errors.New("unsupported media type")
+
+// decode returns how struct fields are named in the decoded document, see documentErrors
+func (o *options) decode(w http.ResponseWriter, r *http.Request, ptr interface{}) (func(reflect.StructField) string, error) {
+	source := o.source
+	if source == FromRequest {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodDelete:
+			source = FromQuery
+		default:
+			source = FromBody
+		}
+	}
+	if source == FromQuery {
+		return fieldName, decodeValues(r.URL.Query(), ptr)
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, o.maxBodyBytes)
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", errUnsupportedMediaType, r.Header.Get("Content-Type"))
+	}
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		decoder := json.NewDecoder(r.Body)
+		if o.disallowUnknown {
+			decoder.DisallowUnknownFields()
+		}
+		if err := decoder.Decode(ptr); err != nil {
+			if err == io.EOF {
+				return nil, errors.New("the body is empty")
+			}
+			return nil, fmt.Errorf("invalid JSON body: %w", err)
+		}
+		return jsonFieldName, nil
+	case mediaType == "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		return fieldName, decodeValues(r.PostForm, ptr)
+	case mediaType == "multipart/form-data":
+		if err := r.ParseMultipartForm(o.maxBodyBytes); err != nil {
+			return nil, err
+		}
+		return fieldName, decodeValues(r.MultipartForm.Value, ptr)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnsupportedMediaType, mediaType)
+	}
+}
+
+func writeStatusProblem(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", checkit.ProblemContentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&checkit.Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Errors: []checkit.ProblemError{},
+	})
+}
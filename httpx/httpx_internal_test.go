@@ -0,0 +1,204 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/dungntm58/checkit"
+)
+
+type createOrder struct {
+	Email    string   `json:"email"`
+	Quantity int      `json:"quantity"`
+	Tags     []string `json:"tags" form:"tag"`
+}
+
+var orderValidator = checkit.Validator{
+	"Email":    checkit.Email(),
+	"Quantity": checkit.Between(1, 10),
+}
+
+func serve(handler http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, r)
+	return recorder
+}
+
+func echoHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		order, ok := Value(r.Context()).(*createOrder)
+		if !ok {
+			t.Errorf("The value must be in the context")
+			return
+		}
+		json.NewEncoder(w).Encode(order)
+	})
+}
+
+func TestMiddleware_json(t *testing.T) {
+	handler := Middleware(createOrder{}, orderValidator)(echoHandler(t))
+	r := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"email": "a@b.co", "quantity": 2}`))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if recorder := serve(handler, r); recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"quantity":2`) {
+		t.Errorf("The request must pass, got %d %s", recorder.Code, recorder.Body)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"email": "a", "quantity": 20}`))
+	r.Header.Set("Content-Type", "application/json")
+	recorder := serve(handler, r)
+	var problem checkit.Problem
+	if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+		t.Fatal(err)
+	}
+	if recorder.Code != http.StatusUnprocessableEntity || recorder.Header().Get("Content-Type") != checkit.ProblemContentType ||
+		len(problem.Errors) != 2 || problem.Errors[0].Pointer != "/email" || problem.Errors[1].Code != checkit.CodeBetween {
+		t.Errorf("A problem must be written, got %d %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestMiddleware_formAndQuery(t *testing.T) {
+	handler := Handle(&createOrder{}, orderValidator, echoHandler(t))
+	form := url.Values{"email": {"a@b.co"}, "quantity": {"3"}, "tag": {"x", "y"}}
+	r := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if recorder := serve(handler, r); recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"tags":["x","y"]`) {
+		t.Errorf("The form must be decoded, got %d %s", recorder.Code, recorder.Body)
+	}
+	r = httptest.NewRequest(http.MethodGet, "/orders?email=a@b.co&quantity=4", nil)
+	if recorder := serve(handler, r); recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"quantity":4`) {
+		t.Errorf("The query must be decoded, got %d %s", recorder.Code, recorder.Body)
+	}
+	r = httptest.NewRequest(http.MethodGet, "/orders?email=a@b.co&quantity=many", nil)
+	if recorder := serve(handler, r); recorder.Code != http.StatusBadRequest {
+		t.Errorf("An invalid number must be rejected, got %d %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestMiddleware_whenUnsupported_shouldReject(t *testing.T) {
+	handler := Handle(createOrder{}, orderValidator, echoHandler(t), DisallowUnknownFields())
+	r := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`<order/>`))
+	r.Header.Set("Content-Type", "application/xml")
+	if recorder := serve(handler, r); recorder.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("An XML body must be rejected, got %d", recorder.Code)
+	}
+	r = httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"email": "a@b.co", "quantity": 1, "price": 2}`))
+	r.Header.Set("Content-Type", "application/json")
+	if recorder := serve(handler, r); recorder.Code != http.StatusBadRequest {
+		t.Errorf("An unknown field must be rejected, got %d", recorder.Code)
+	}
+}
+
+func TestMiddleware_withAcceptLanguage(t *testing.T) {
+	handler := Handle(map[string]interface{}{}, checkit.Validator{"email": checkit.Email()}, http.NotFoundHandler(),
+		WithAcceptLanguage(), WithProblemOptions(checkit.AsJSONAPI()))
+	r := httptest.NewRequest(http.MethodGet, "/orders?email=a", nil)
+	r.Header.Set("Accept-Language", "vi")
+	recorder := serve(handler, r)
+	var doc checkit.JSONAPIErrors
+	if err := json.Unmarshal(recorder.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Errors) != 1 || doc.Errors[0].Detail != "email phải là địa chỉ email hợp lệ." {
+		t.Errorf("The message must be localized, got %s", recorder.Body)
+	}
+}
+
+func TestMiddleware_lookupError(t *testing.T) {
+	lookup := checkit.LookupFunc(func(ctx context.Context, key interface{}) (bool, error) {
+		return false, errors.New("connection refused")
+	})
+	handler := Middleware(createOrder{}, checkit.Validator{"Email": checkit.Unique(lookup)})(echoHandler(t))
+	r := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"email": "a@b.co"}`))
+	r.Header.Set("Content-Type", "application/json")
+	recorder := serve(handler, r)
+	if recorder.Code != http.StatusInternalServerError || strings.Contains(recorder.Body.String(), "connection refused") {
+		t.Errorf("A failing lookup must be answered with a 500 which does not leak the error, got %d %s", recorder.Code, recorder.Body)
+	}
+}
+
+type panickingRule struct{}
+
+func (panickingRule) Validate(value interface{}) (bool, error) {
+	panic("secret state")
+}
+
+func TestMiddleware_whenRulePanics_shouldAnswer500(t *testing.T) {
+	handler := Middleware(createOrder{}, checkit.Validator{"Email": panickingRule{}})(echoHandler(t))
+	r := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"email": "a@b.co"}`))
+	r.Header.Set("Content-Type", "application/json")
+	recorder := serve(handler, r)
+	if recorder.Code != http.StatusInternalServerError || strings.Contains(recorder.Body.String(), "secret") {
+		t.Errorf("A panicking rule must be answered with a 500 which does not leak the panic, got %d %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestMiddleware_whenBodyIsTooLarge_shouldAnswer413(t *testing.T) {
+	handler := Middleware(createOrder{}, orderValidator, WithMaxBodyBytes(16))(echoHandler(t))
+	for _, contentType := range []string{"application/json", "application/x-www-form-urlencoded"} {
+		body := `{"email": "a@b.co", "quantity": 2}`
+		if contentType != "application/json" {
+			body = "email=a@b.co&quantity=2"
+		}
+		r := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		if recorder := serve(handler, r); recorder.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("A %s body over the limit must be answered with a 413, got %d %s", contentType, recorder.Code, recorder.Body)
+		}
+	}
+}
+
+func TestMiddleware_formPointers(t *testing.T) {
+	validator := checkit.Validator{"Tags.all": checkit.MaxLength(1), "Email": checkit.Email()}
+	handler := Middleware(createOrder{}, validator)(echoHandler(t))
+	r := httptest.NewRequest(http.MethodGet, "/orders?email=a@b.co&tag=x&tag=yy", nil)
+	recorder := serve(handler, r)
+	var problem checkit.Problem
+	if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+		t.Fatal(err)
+	}
+	if len(problem.Errors) != 1 || problem.Errors[0].Pointer != "/tag/1" {
+		t.Errorf("The pointer must name the query parameter, got %s", recorder.Body)
+	}
+}
+
+type shippingAddress struct {
+	City string `form:"city"`
+}
+
+type auditFields struct {
+	Source string `form:"source"`
+}
+
+type shippingOrder struct {
+	auditFields
+	Email    string           `form:"email"`
+	Address  shippingAddress  `form:"address"`
+	Billing  *shippingAddress `form:"billing"`
+	Previous *shippingOrder   `form:"previous"`
+}
+
+func TestDecodeValues_shouldFillNestedAndEmbeddedStructs(t *testing.T) {
+	var order shippingOrder
+	values := url.Values{"email": {"a@b.co"}, "address.city": {"Hue"}, "source": {"web"}, "previous.email": {"c@d.co"}}
+	if err := decodeValues(values, &order); err != nil {
+		t.Fatal(err)
+	}
+	if order.Email != "a@b.co" || order.Address.City != "Hue" || order.Source != "web" {
+		t.Errorf("The nested and embedded fields must be decoded, got %+v", order)
+	}
+	if order.Billing != nil {
+		t.Errorf("A pointer to a struct without parameters must stay nil, got %+v", order.Billing)
+	}
+	if order.Previous == nil || order.Previous.Email != "c@d.co" || order.Previous.Previous != nil {
+		t.Errorf("A recursive struct must be decoded as deep as its parameters, got %+v", order.Previous)
+	}
+	if err := decodeValues(url.Values{"address": {"Hue"}}, &order); err == nil {
+		t.Errorf("A value for a struct field must be rejected")
+	}
+}
@@ -0,0 +1,238 @@
+package checkit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// Catalog maps rule codes such as "max_length" to message templates, see WithMessage for the template data.
+// The "value" entry labels a value validated without a key path,
+// the "value_missing" entry is used for errors wrapping ErrValueMissing.
+type Catalog map[string]string
+
+// Localizer renders messages from catalogs, a message missing from the catalog of a locale
+// is looked up in its parent locales, e.g. "vi-VN" then "vi", and finally in the fallback locale.
+type Localizer struct {
+	mutex     sync.RWMutex
+	fallback  language.Tag
+	tags      []language.Tag
+	matcher   language.Matcher
+	templates map[language.Tag]map[string]*template.Template
+}
+
+// DefaultLocalizer holds the built-in catalogs and falls back to English
+var DefaultLocalizer = NewLocalizer(language.English)
+
+// NewLocalizer returns a Localizer holding the built-in catalogs for en and vi
+func NewLocalizer(fallback language.Tag) *Localizer {
+	var l = &Localizer{
+		fallback:  fallback,
+		templates: make(map[language.Tag]map[string]*template.Template),
+	}
+	for tag, catalog := range builtinCatalogs {
+		if err := l.AddCatalog(tag, catalog); err != nil {
+			panic(err)
+		}
+	}
+	return l
+}
+
+// AddCatalog merges the messages into the catalog of the locale
+func (l *Localizer) AddCatalog(tag language.Tag, catalog Catalog) error {
+	var templates = make(map[string]*template.Template, len(catalog))
+	for code, message := range catalog {
+		tmpl, err := template.New(code).Option("missingkey=zero").Parse(message)
+		if err != nil {
+			return fmt.Errorf("message %q of locale %s: %w", code, tag, err)
+		}
+		templates[code] = tmpl
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, ok := l.templates[tag]; !ok {
+		l.templates[tag] = make(map[string]*template.Template, len(templates))
+		l.tags = append(l.tags, tag)
+		l.matcher = nil
+	}
+	for code, tmpl := range templates {
+		l.templates[tag][code] = tmpl
+	}
+	return nil
+}
+
+// LoadJSON reads a catalog written as a JSON object of messages keyed by rule code
+func (l *Localizer) LoadJSON(tag language.Tag, r io.Reader) error {
+	var catalog Catalog
+	if err := json.NewDecoder(r).Decode(&catalog); err != nil {
+		return fmt.Errorf("catalog of locale %s: %w", tag, err)
+	}
+	return l.AddCatalog(tag, catalog)
+}
+
+// LoadYAML reads a catalog written as a YAML mapping of messages keyed by rule code
+func (l *Localizer) LoadYAML(tag language.Tag, r io.Reader) error {
+	var catalog Catalog
+	if err := yaml.NewDecoder(r).Decode(&catalog); err != nil {
+		return fmt.Errorf("catalog of locale %s: %w", tag, err)
+	}
+	return l.AddCatalog(tag, catalog)
+}
+
+// LoadFile reads a .json, .yaml or .yml catalog, the file name is the locale, e.g. "locales/pt-BR.yaml"
+func (l *Localizer) LoadFile(path string) error {
+	ext := filepath.Ext(path)
+	tag, err := language.Parse(strings.TrimSuffix(filepath.Base(path), ext))
+	if err != nil {
+		return fmt.Errorf("catalog %s: %w", path, err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	switch strings.ToLower(ext) {
+	case ".json":
+		return l.LoadJSON(tag, f)
+	case ".yaml", ".yml":
+		return l.LoadYAML(tag, f)
+	default:
+		return fmt.Errorf("catalog %s: unsupported format %q", path, ext)
+	}
+}
+
+// Match returns the locale of the catalog which best matches the preferred locales, the fallback locale if none does
+func (l *Localizer) Match(preferred ...language.Tag) language.Tag {
+	matcher, tags := l.matcherOf()
+	_, index, confidence := matcher.Match(preferred...)
+	if confidence == language.No || index == 0 {
+		return l.fallback
+	}
+	return tags[index-1]
+}
+
+// matcherOf returns the matcher of the loaded locales with the locales it matches,
+// the matcher is built again after a catalog is loaded
+func (l *Localizer) matcherOf() (language.Matcher, []language.Tag) {
+	l.mutex.RLock()
+	matcher, tags := l.matcher, l.tags
+	l.mutex.RUnlock()
+	if matcher != nil {
+		return matcher, tags
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.matcher == nil {
+		// The first tag is the default of the matcher
+		var tags = append([]language.Tag{l.fallback}, l.tags...)
+		l.matcher = language.NewMatcher(tags)
+	}
+	return l.matcher, l.tags
+}
+
+// message renders the message of a rule code in the locale, ok is false when no catalog has one
+func (l *Localizer) message(tag language.Tag, code string, data MessageData) (string, bool) {
+	tmpl := l.lookup(tag, code)
+	if tmpl == nil {
+		return "", false
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", false
+	}
+	return sb.String(), true
+}
+
+func (l *Localizer) lookup(tag language.Tag, code string) *template.Template {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	for t := tag; ; t = t.Parent() {
+		if tmpl, ok := l.templates[t][code]; ok {
+			return tmpl
+		}
+		if t == language.Und {
+			break
+		}
+	}
+	return l.templates[l.fallback][code]
+}
+
+// WithLocale renders messages from the catalog best matching the preferred locales, see Localizer
+func WithLocale(preferred ...language.Tag) Option {
+	return func(o *options) {
+		o.locales = preferred
+		o.localized = true
+	}
+}
+
+// WithAcceptLanguage renders messages from the catalog best matching an Accept-Language header,
+// the fallback locale is used when the header is empty or invalid
+func WithAcceptLanguage(header string) Option {
+	tags, _, _ := language.ParseAcceptLanguage(header)
+	return WithLocale(tags...)
+}
+
+// WithLocalizer replaces DefaultLocalizer for the call
+func WithLocalizer(localizer *Localizer) Option {
+	return func(o *options) {
+		o.localizer = localizer
+	}
+}
+
+// localize replaces the messages of the errors with the catalog messages when a locale is set,
+// and names the value of the default messages with its label otherwise.
+// Messages set by WithMessage and errors of rules without a code are kept
+func (o *options) localize(fieldErr *FieldError) *FieldError {
+	if fieldErr.customMessage || len(fieldErr.Rule) == 0 {
+		return fieldErr
+	}
+	if !o.localized {
+		return o.labelMessage(fieldErr)
+	}
+	localizer := o.localizer
+	if localizer == nil {
+		localizer = DefaultLocalizer
+	}
+	tag := localizer.Match(o.locales...)
+	code := fieldErr.Rule
+	if errors.Is(fieldErr.Err, ErrValueMissing) {
+		code = CodeValueMissing
+	}
+	label := o.labelFor(fieldErr.KeyPath)
+	if len(label) == 0 {
+		label, _ = localizer.message(tag, "value", MessageData{})
+	}
+	message, ok := localizer.message(tag, code, MessageData{
+		Label:   label,
+		KeyPath: fieldErr.KeyPath,
+		Rule:    fieldErr.Rule,
+		Value:   fieldErr.Value,
+		Params:  fieldErr.Params,
+	})
+	if !ok {
+		return fieldErr
+	}
+	localized := *fieldErr
+	localized.Message = message
+	return &localized
+}
+
+func (o *options) localizeAll(errs []*FieldError) []*FieldError {
+	if !o.localized && len(o.labels) == 0 {
+		return errs
+	}
+	var localized = make([]*FieldError, len(errs))
+	for i, fieldErr := range errs {
+		localized[i] = o.localize(fieldErr)
+	}
+	return localized
+}
@@ -0,0 +1,105 @@
+package checkit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestWithAcceptLanguage(t *testing.T) {
+	validator := Validator{
+		"name":  MinLength(3),
+		"email": WithMessage(Email(), "{{.Label}} is invalid."),
+	}
+	value := map[string]interface{}{"name": "ab", "email": "x"}
+	_, err := validator.ValidateAll(value, WithAcceptLanguage("fr-CH, vi-VN;q=0.9, en;q=0.8"), WithLabel("name", "Tên"))
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Two errors must be reported, got %v", err)
+	}
+	if errs[0].Message != "email is invalid." {
+		t.Errorf("A custom message must not be localized, got %v", errs[0].Message)
+	}
+	if errs[1].Message != "Tên phải có độ dài tối thiểu 3." {
+		t.Errorf("The message must be in Vietnamese, got %v", errs[1].Message)
+	}
+	_, err = Validator{"name": MinLength(3)}.ValidateSync(value)
+	if fieldErr, ok := err.(*FieldError); !ok || !strings.HasPrefix(fieldErr.Message, "The value must have a length property") {
+		t.Errorf("Messages must not be localized without a locale, got %v", err)
+	}
+	_, err = Validator{"": String()}.ValidateSync(1, WithLocale(language.German))
+	if fieldErr, ok := err.(*FieldError); !ok || fieldErr.Message != "The value must be a string." {
+		t.Errorf("The fallback locale must be used, got %v", err)
+	}
+}
+
+func TestLocalizer_fallbackChain(t *testing.T) {
+	localizer := NewLocalizer(language.English)
+	if err := localizer.LoadJSON(language.MustParse("vi-VN"), strings.NewReader(`{"required": "Bắt buộc nhập {{.Label}}."}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := localizer.LoadYAML(language.Vietnamese, strings.NewReader("max: '{{.Label}} tối đa {{.Params.max}}.'\n")); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "vi-VN.yml"), []byte("string: '{{.Label}} phải là văn bản.'\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := localizer.LoadFile(filepath.Join(dir, "vi-VN.yml")); err != nil {
+		t.Fatal(err)
+	}
+	validator := Validator{"a": Required(), "b": Max(1), "c": String(), "d": Luhn()}
+	_, err := validator.ValidateAll(map[string]interface{}{"b": 2, "c": 1, "d": "1"}, WithLocalizer(localizer), WithLocale(language.MustParse("vi-VN")))
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 4 {
+		t.Fatalf("Four errors must be reported, got %v", err)
+	}
+	var expected = []string{"Bắt buộc nhập a.", "b tối đa 1.", "c phải là văn bản.", "d phải vượt qua kiểm tra Luhn."}
+	for i, message := range expected {
+		if errs[i].Message != message {
+			t.Errorf("Expected %q, got %q", message, errs[i].Message)
+		}
+	}
+	if err := localizer.AddCatalog(language.Vietnamese, Catalog{"max": "{{.Label"}); err == nil {
+		t.Errorf("An invalid template must be reported")
+	}
+}
+
+func TestBuiltinCatalogs_shouldHaveTheSameCodes(t *testing.T) {
+	for code := range catalogEn {
+		if _, ok := catalogVi[code]; !ok {
+			t.Errorf("Missing Vietnamese message for %q", code)
+		}
+	}
+	for code := range catalogVi {
+		if _, ok := catalogEn[code]; !ok {
+			t.Errorf("Missing English message for %q", code)
+		}
+	}
+}
+
+func TestLocalizer_Match_whenCatalogsAreAddedConcurrently_shouldMatchLoadedLocales(t *testing.T) {
+	localizer := NewLocalizer(language.English)
+	var wg sync.WaitGroup
+	for _, tag := range []language.Tag{language.French, language.German, language.Japanese} {
+		wg.Add(2)
+		go func(tag language.Tag) {
+			defer wg.Done()
+			if err := localizer.AddCatalog(tag, Catalog{"email": "{{.Label}}"}); err != nil {
+				t.Error(err)
+			}
+		}(tag)
+		go func() {
+			defer wg.Done()
+			localizer.Match(language.Vietnamese)
+		}()
+	}
+	wg.Wait()
+	if tag := localizer.Match(language.German); tag != language.German {
+		t.Errorf("A loaded locale must be matched, got %v", tag)
+	}
+}
@@ -0,0 +1,222 @@
+package checkit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ValidateJSON validates a JSON document like ValidateAll without unmarshalling it into Go values.
+// The document is read as a token stream and only the values reachable by the key paths of the validator,
+// or by the key paths given to built-in rules such as RequiredIf, are kept.
+// Numbers are read as json.Number and given to rules as int64, or uint64 beyond the range of int64, when they are integers,
+// float64 otherwise, so integers keep their precision while fractions and larger integers are compared as float64.
+// A document which is not valid JSON is reported by the error of the decoder, see FieldError.Pointer for error paths.
+func ValidateJSON(r io.Reader, validator Validator, opts ...Option) (bool, error) {
+	return validator.ValidateJSON(r, opts...)
+}
+
+// ValidateJSON ...
+func (v Validator) ValidateJSON(r io.Reader, opts ...Option) (bool, error) {
+	return v.fields().validateJSON(r, newOptions(opts))
+}
+
+// ValidateJSON ...
+func (o *OrderedValidator) ValidateJSON(r io.Reader, opts ...Option) (bool, error) {
+	return o.fields.validateJSON(r, newOptions(opts))
+}
+
+func (fs fields) validateJSON(r io.Reader, o *options) (bool, error) {
+	var root = &jsonPathNode{}
+	for _, f := range fs {
+		root.insert(splitKeyPath(f.keyPath))
+		info, _ := Describe(f.validating)
+		for _, keyPath := range referencedKeyPaths(info, f.keyPath) {
+			root.insert(splitKeyPath(keyPath))
+		}
+	}
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	value, err := decodePrunedJSON(decoder, []*jsonPathNode{root})
+	if err != nil {
+		return false, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		if err == nil {
+			err = errors.New("invalid character after top-level value")
+		}
+		return false, err
+	}
+	return fs.validateAll(value, o)
+}
+
+// jsonPathNode is a node of the tree of key paths, any, all, first and last match every element of an array
+type jsonPathNode struct {
+	terminal bool
+	children map[string]*jsonPathNode
+	elements *jsonPathNode
+}
+
+func (n *jsonPathNode) insert(keys []string) {
+	if len(keys) == 0 {
+		n.terminal = true
+		return
+	}
+	if n.children == nil {
+		n.children = make(map[string]*jsonPathNode)
+	}
+	child, ok := n.children[keys[0]]
+	if !ok {
+		child = &jsonPathNode{}
+		n.children[keys[0]] = child
+	}
+	child.insert(keys[1:])
+	switch keys[0] {
+	case keyAll, keyAny, keyFirst, keyLast:
+		if n.elements == nil {
+			n.elements = &jsonPathNode{}
+		}
+		n.elements.insert(keys[1:])
+	}
+}
+
+// referencedKeyPaths lists the key paths given to the rules, relative ones are resolved from the parent of keyPath
+func referencedKeyPaths(info RuleInfo, keyPath string) []string {
+	var keyPaths []string
+	if references, ok := info.Params["key_paths"].([]string); ok {
+		keyPaths = append(keyPaths, references...)
+	}
+	if reference, ok := info.Params["key_path"].(string); ok {
+		keyPaths = append(keyPaths, reference)
+	}
+	for i, reference := range keyPaths {
+		if len(reference) > 0 && reference[0] == '.' {
+			var parentKeyPath string
+			if keys := splitKeyPath(keyPath); len(keys) > 0 {
+				parentKeyPath = joinKeyPaths(keys[:len(keys)-1])
+			}
+			keyPaths[i] = joinKeyPath(parentKeyPath, reference[1:])
+		}
+	}
+	for _, rule := range info.Rules {
+		keyPaths = append(keyPaths, referencedKeyPaths(rule, keyPath)...)
+	}
+	return keyPaths
+}
+
+func joinKeyPaths(keys []string) string {
+	var keyPath string
+	for _, key := range keys {
+		keyPath = joinKeyPath(keyPath, key)
+	}
+	return keyPath
+}
+
+// decodePrunedJSON reads the next value, the whole value when a key path ends at it,
+// the parts reachable by the key paths otherwise. Skipped elements of arrays are kept as nil so indexes do not change.
+func decodePrunedJSON(decoder *json.Decoder, nodes []*jsonPathNode) (interface{}, error) {
+	for _, node := range nodes {
+		if node.terminal {
+			var value interface{}
+			err := decoder.Decode(&value)
+			return value, err
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, skipJSON(decoder)
+	}
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		var object = make(map[string]interface{})
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			key := keyToken.(string)
+			var children []*jsonPathNode
+			for _, node := range nodes {
+				if child, ok := node.children[key]; ok {
+					children = append(children, child)
+				}
+			}
+			if len(children) == 0 {
+				if err := skipJSON(decoder); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if object[key], err = decodePrunedJSON(decoder, children); err != nil {
+				return nil, err
+			}
+		}
+		_, err := decoder.Token()
+		return object, err
+	case json.Delim('['):
+		var array = []interface{}{}
+		for i := 0; decoder.More(); i++ {
+			var children []*jsonPathNode
+			for _, node := range nodes {
+				if child, ok := node.children[strconv.Itoa(i)]; ok {
+					children = append(children, child)
+				}
+				if node.elements != nil {
+					children = append(children, node.elements)
+				}
+			}
+			element, err := decodePrunedJSON(decoder, children)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, element)
+		}
+		_, err := decoder.Token()
+		return array, err
+	default:
+		return token, nil
+	}
+}
+
+// skipJSON reads the next value without keeping it
+func skipJSON(decoder *json.Decoder) error {
+	var depth int
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// numberOf converts a json.Number into an int64, or a uint64 beyond the range of int64, when it is an integer,
+// into a float64 otherwise
+func numberOf(n json.Number) interface{} {
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if u, err := strconv.ParseUint(string(n), 10, 64); err == nil {
+		return u
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return fmt.Sprint(n)
+}
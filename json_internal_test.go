@@ -0,0 +1,82 @@
+package checkit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateJSON(t *testing.T) {
+	document := `{
+		"id": 9007199254740993,
+		"email": "a@b.co",
+		"ignored": {"deep": [1, 2, {"x": "y"}]},
+		"items": [{"price": 1.5, "note": "a"}, {"price": 0}, {"price": 2}],
+		"range": {"min": 5, "max": 3}
+	}`
+	validator := Validator{
+		"id":              Equals(int64(9007199254740993)),
+		"email":           Email(),
+		"items.all.price": GreaterThan(0),
+		"items.first":     PlainObject(),
+		"range.max":       GreaterThanField(".min"),
+		"missing":         Optional(String()),
+	}
+	_, err := ValidateJSON(strings.NewReader(document), validator)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Two errors must be reported, got %v", err)
+	}
+	var pointers []string
+	for _, fieldErr := range errs {
+		pointers = append(pointers, fieldErr.Pointer())
+	}
+	if !reflect.DeepEqual(pointers, []string{"/items/1/price", "/range/max"}) {
+		t.Errorf("Unexpected pointers %v", pointers)
+	}
+	if r, err := ValidateJSON(strings.NewReader(`{"a": [1, 2`), Validator{"a": Array()}); r || err == nil {
+		t.Errorf("A truncated document must fail, got %v", err)
+	}
+	if r, err := ValidateJSON(strings.NewReader(`{} {}`), Validator{"a": Optional(Array())}); r || err == nil {
+		t.Errorf("Trailing data must fail, got %v", err)
+	}
+	if r, err := Schema().Field("", PlainObject()).Field("a.1", Equals(int64(2))).ValidateJSON(strings.NewReader(`{"a": [1, 2]}`)); !r {
+		t.Errorf("The document must pass, got %v", err)
+	}
+}
+
+func TestDecodePrunedJSON(t *testing.T) {
+	var root = &jsonPathNode{}
+	root.insert(splitKeyPath("a.1.b"))
+	root.insert(splitKeyPath("c"))
+	info, _ := Describe(RequiredIf(".d", 1))
+	if keyPaths := referencedKeyPaths(info, "x.y"); !reflect.DeepEqual(keyPaths, []string{"x.d"}) {
+		t.Errorf("Relative key paths must resolve from the parent, got %v", keyPaths)
+	}
+	decoder := json.NewDecoder(strings.NewReader(`{"a": [{"b": 1, "z": 2}, {"b": 3, "z": 4}], "c": {"k": [true]}, "e": "skip"}`))
+	decoder.UseNumber()
+	value, err := decodePrunedJSON(decoder, []*jsonPathNode{root})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"a": []interface{}{nil, map[string]interface{}{"b": json.Number("3")}},
+		"c": map[string]interface{}{"k": []interface{}{true}},
+	}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("Unexpected pruned value %v", value)
+	}
+}
+
+func TestValidateJSON_shouldConvertReferencedNumbers(t *testing.T) {
+	validator := Validator{"end": GreaterThanField("start"), "memo": RequiredIf("type", 2)}
+	_, err := ValidateJSON(strings.NewReader(`{"start": 1, "end": 5, "type": 2, "memo": null}`), validator)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].KeyPath != "memo" || errs[0].Rule != CodeRequiredIf {
+		t.Errorf("Only memo must fail, got %v", err)
+	}
+	if n := numberOf(json.Number("18446744073709551615")); n != uint64(18446744073709551615) {
+		t.Errorf("A large integer must keep its precision, got %v", n)
+	}
+}
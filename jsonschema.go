@@ -0,0 +1,676 @@
+package checkit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONSchemaError lists the keywords of a JSON Schema which cannot be compiled, by their JSON Pointer in the schema
+type JSONSchemaError struct {
+	Unsupported []string
+}
+
+func (e *JSONSchemaError) Error() string {
+	return "unsupported JSON Schema keywords: " + strings.Join(e.Unsupported, ", ")
+}
+
+// LoadJSONSchema reads a JSON Schema document, see CompileJSONSchema
+func LoadJSONSchema(r io.Reader) (Validator, error) {
+	document, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return CompileJSONSchema(document)
+}
+
+// CompileJSONSchema compiles a JSON Schema (draft 2020-12) document into a Validator holding its rules at the root key path.
+// The supported keywords are type, enum, const, required, minLength, maxLength, minimum, maximum, exclusiveMinimum,
+// exclusiveMaximum, pattern, format (email, uri, uuid, ipv4 and ipv6), items, properties, additionalProperties,
+// allOf, anyOf, oneOf, not and $ref to "#" or a JSON Pointer of the document. Annotations such as title are ignored,
+// the other keywords are reported by a JSONSchemaError.
+func CompileJSONSchema(document []byte) (Validator, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	var root interface{}
+	if err := decoder.Decode(&root); err != nil {
+		return nil, err
+	}
+	compiler := &jsonSchemaCompiler{root: root, refs: make(map[string]*jsonSchemaRef)}
+	validating, err := compiler.compile(root, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(compiler.unsupported) > 0 {
+		return nil, &JSONSchemaError{Unsupported: compiler.unsupported}
+	}
+	return Validator{"": validating}, nil
+}
+
+// jsonSchemaAnnotations are the keywords which do not constrain values
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "$defs": true, "definitions": true,
+	"title": true, "description": true, "default": true, "examples": true,
+	"deprecated": true, "readOnly": true, "writeOnly": true,
+}
+
+var jsonSchemaFormats = map[string]func() Validating{
+	"email": Email,
+	"ipv4":  Ipv4,
+	"ipv6":  Ipv6,
+	"uri":   jsonSchemaURI,
+	"uuid":  jsonSchemaUUID,
+}
+
+// regexJSONSchemaUUID accepts every RFC 9562 UUID, including the nil and max UUIDs and versions 6 to 8
+var regexJSONSchemaUUID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// regexURIScheme is the scheme of RFC 3986
+var regexURIScheme = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*$`)
+
+// jsonSchemaURI passes absolute URIs of any scheme, e.g. urn:isbn:0451450523 or ftp://example.com
+func jsonSchemaURI() Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			s, ok := stringOf(value)
+			if !ok {
+				return false, errors.New("The value must be a string")
+			}
+			u, err := url.Parse(s)
+			return err == nil && regexURIScheme.MatchString(u.Scheme), nil
+		},
+		errorMessage: "The value must be an absolute URI.",
+		rule:         CodeURL,
+	}
+}
+
+func jsonSchemaUUID() Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			return matchAnyWithRegex(regexJSONSchemaUUID, value)
+		},
+		errorMessage: "The value must be a valid UUID.",
+		rule:         CodeUUID,
+	}
+}
+
+type jsonSchemaCompiler struct {
+	root        interface{}
+	refs        map[string]*jsonSchemaRef
+	unsupported []string
+}
+
+// compile builds the rules of a schema, pointer locates the schema in the document for errors
+func (c *jsonSchemaCompiler) compile(schema interface{}, pointer string) (Validating, error) {
+	switch s := schema.(type) {
+	case bool:
+		if s {
+			return CompoundValidating{}, nil
+		}
+		return notAllowed(), nil
+	case map[string]interface{}:
+		return c.compileObject(s, pointer)
+	default:
+		return nil, fmt.Errorf("%s: a schema must be an object or a boolean", pointerOrRoot(pointer))
+	}
+}
+
+func (c *jsonSchemaCompiler) compileObject(schema map[string]interface{}, pointer string) (Validating, error) {
+	var keywords = make([]string, 0, len(schema))
+	for keyword := range schema {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	var (
+		rules  CompoundValidating
+		object = &jsonSchemaObject{}
+	)
+	for _, keyword := range keywords {
+		value := schema[keyword]
+		keywordPointer := pointer + "/" + pointerEscaper.Replace(keyword)
+		var (
+			rule Validating
+			err  error
+		)
+		switch keyword {
+		case "type":
+			rule, err = jsonSchemaType(value, keywordPointer)
+		case "enum":
+			values, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: expected an array", keywordPointer)
+			}
+			var branches = make([]Validating, len(values))
+			for i, v := range values {
+				branches[i] = jsonSchemaConst(v)
+			}
+			rule = AnyOf(branches...)
+		case "const":
+			rule = jsonSchemaConst(value)
+		case "minLength", "maxLength":
+			var length int
+			if length, err = jsonSchemaInt(value, keywordPointer); err == nil {
+				rule = jsonSchemaKeyword("string", jsonSchemaLength(keyword == "minLength", length))
+			}
+		case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+			number, ok := value.(json.Number)
+			if !ok {
+				return nil, fmt.Errorf("%s: expected a number", keywordPointer)
+			}
+			var constructors = map[string]func(interface{}) Validating{
+				"minimum":          GreaterThanEqualTo,
+				"maximum":          LessThanEqualTo,
+				"exclusiveMinimum": GreaterThan,
+				"exclusiveMaximum": LessThan,
+			}
+			rule = jsonSchemaKeyword("number", constructors[keyword](numberOf(number)))
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: expected a string", keywordPointer)
+			}
+			var regex *regexp.Regexp
+			if regex, err = regexp.Compile(pattern); err == nil {
+				rule = jsonSchemaKeyword("string", MatchesRegexp(regex))
+			}
+		case "format":
+			format, _ := value.(string)
+			constructor, ok := jsonSchemaFormats[format]
+			if !ok {
+				c.unsupported = append(c.unsupported, fmt.Sprintf("%s (%v)", keywordPointer, value))
+				continue
+			}
+			rule = jsonSchemaKeyword("string", constructor())
+		case "items":
+			if rule, err = c.compile(value, keywordPointer); err == nil {
+				rule = jsonSchemaKeyword("array", Each(rule))
+			}
+		case "required":
+			names, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: expected an array", keywordPointer)
+			}
+			for _, name := range names {
+				s, ok := name.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: expected an array of strings", keywordPointer)
+				}
+				object.required = append(object.required, s)
+			}
+			continue
+		case "properties":
+			properties, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: expected an object", keywordPointer)
+			}
+			var names = make([]string, 0, len(properties))
+			for name := range properties {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			object.properties = make(map[string]Validating, len(properties))
+			for _, name := range names {
+				if object.properties[name], err = c.compile(properties[name], keywordPointer+"/"+pointerEscaper.Replace(name)); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		case "additionalProperties":
+			if object.additionalProperties, err = c.compile(value, keywordPointer); err != nil {
+				return nil, err
+			}
+			continue
+		case "allOf", "anyOf", "oneOf":
+			var branches []Validating
+			if branches, err = c.compileAll(value, keywordPointer); err == nil {
+				switch keyword {
+				case "allOf":
+					rule = CompoundValidating(branches)
+				case "anyOf":
+					rule = AnyOf(branches...)
+				default:
+					rule = OneOf(branches...)
+				}
+			}
+		case "not":
+			if rule, err = c.compile(value, keywordPointer); err == nil {
+				rule = Not(rule)
+			}
+		case "$ref":
+			ref, _ := value.(string)
+			rule, err = c.ref(ref, keywordPointer)
+		default:
+			if !jsonSchemaAnnotations[keyword] {
+				c.unsupported = append(c.unsupported, keywordPointer)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if len(object.required) > 0 || object.properties != nil || object.additionalProperties != nil {
+		rules = append(rules, object)
+	}
+	if len(rules) == 1 {
+		return rules[0], nil
+	}
+	return rules, nil
+}
+
+func (c *jsonSchemaCompiler) compileAll(value interface{}, pointer string) ([]Validating, error) {
+	schemas, ok := value.([]interface{})
+	if !ok || len(schemas) == 0 {
+		return nil, fmt.Errorf("%s: expected a non-empty array", pointer)
+	}
+	var validatings = make([]Validating, len(schemas))
+	for i, schema := range schemas {
+		var err error
+		if validatings[i], err = c.compile(schema, pointer+"/"+strconv.Itoa(i)); err != nil {
+			return nil, err
+		}
+	}
+	return validatings, nil
+}
+
+// ref compiles the target of a reference once, a reference to a schema being compiled is resolved when it is validated
+func (c *jsonSchemaCompiler) ref(ref string, pointer string) (Validating, error) {
+	if r, ok := c.refs[ref]; ok {
+		return r, nil
+	}
+	if !strings.HasPrefix(ref, "#") {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("%s (%s)", pointer, ref))
+		return CompoundValidating{}, nil
+	}
+	target, err := resolveJSONPointer(c.root, strings.TrimPrefix(ref, "#"))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", pointer, err)
+	}
+	r := &jsonSchemaRef{ref: ref}
+	c.refs[ref] = r
+	if r.validating, err = c.compile(target, strings.TrimPrefix(ref, "#")); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// resolveJSONPointer returns the value of a document at an RFC 6901 JSON Pointer
+func resolveJSONPointer(document interface{}, pointer string) (interface{}, error) {
+	if pointer == "" {
+		return document, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON Pointer %q", pointer)
+	}
+	var value = document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[token]; !ok {
+				return nil, fmt.Errorf("JSON Pointer %q not found", pointer)
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, fmt.Errorf("JSON Pointer %q not found", pointer)
+			}
+			value = v[i]
+		default:
+			return nil, fmt.Errorf("JSON Pointer %q not found", pointer)
+		}
+	}
+	return value, nil
+}
+
+func pointerOrRoot(pointer string) string {
+	if pointer == "" {
+		return "/"
+	}
+	return pointer
+}
+
+func jsonSchemaType(value interface{}, pointer string) (Validating, error) {
+	var types []interface{}
+	switch v := value.(type) {
+	case string:
+		types = []interface{}{v}
+	case []interface{}:
+		types = v
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("%s: expected a type or an array of types", pointer)
+	}
+	var branches = make([]Validating, len(types))
+	for i, t := range types {
+		switch t {
+		case "null":
+			branches[i] = Equals(nil)
+		case "boolean":
+			branches[i] = Boolean()
+		case "string":
+			branches[i] = String()
+		case "number":
+			branches[i] = jsonNumber(false)
+		case "integer":
+			branches[i] = jsonNumber(true)
+		case "array":
+			branches[i] = Array()
+		case "object":
+			branches[i] = PlainObject()
+		default:
+			return nil, fmt.Errorf("%s: unknown type %v", pointer, t)
+		}
+	}
+	if len(branches) == 1 {
+		return branches[0], nil
+	}
+	return AnyOf(branches...), nil
+}
+
+func jsonSchemaInt(value interface{}, pointer string) (int, error) {
+	if number, ok := value.(json.Number); ok {
+		if i, err := number.Int64(); err == nil && i >= 0 {
+			return int(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%s: expected a non-negative integer", pointer)
+}
+
+// jsonSchemaValue converts the numbers of a value of the schema, as ValidateJSON does
+func jsonSchemaValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		return numberOf(v)
+	case []interface{}:
+		var values = make([]interface{}, len(v))
+		for i, element := range v {
+			values[i] = jsonSchemaValue(element)
+		}
+		return values
+	case map[string]interface{}:
+		var values = make(map[string]interface{}, len(v))
+		for key, element := range v {
+			values[key] = jsonSchemaValue(element)
+		}
+		return values
+	default:
+		return v
+	}
+}
+
+// jsonSchemaConst passes the values equal to a value of the schema as JSON values are,
+// numbers by value whatever their Go type, arrays and objects element by element
+func jsonSchemaConst(v interface{}) Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			return jsonEqual(value, v), nil
+		},
+		errorMessage: "The value must be equal to the given value.",
+		rule:         CodeEquals,
+		acceptsNil:   true,
+		params:       map[string]interface{}{"value": jsonSchemaValue(v)},
+	}
+}
+
+func jsonEqual(lhs interface{}, rhs interface{}) bool {
+	lhs, rhs = jsonComparable(lhs), jsonComparable(rhs)
+	kind := jsonKindOf(lhs)
+	if kind != jsonKindOf(rhs) {
+		return false
+	}
+	switch kind {
+	case "array":
+		l, r := reflect.ValueOf(lhs), reflect.ValueOf(rhs)
+		if l.Len() != r.Len() {
+			return false
+		}
+		for i := 0; i < l.Len(); i++ {
+			if !jsonEqual(getReferenceValue(l.Index(i)), getReferenceValue(r.Index(i))) {
+				return false
+			}
+		}
+		return true
+	case "object":
+		l, lErr := mapElementsOf(lhs, false)
+		r, rErr := mapElementsOf(rhs, false)
+		if lErr != nil || rErr != nil || len(l) != len(r) {
+			return false
+		}
+		for i := range l {
+			if l[i].key != r[i].key || !jsonEqual(l[i].value, r[i].value) {
+				return false
+			}
+		}
+		return true
+	default:
+		return isEqual(lhs, rhs)
+	}
+}
+
+// jsonComparable dereferences a value and converts a json.Number as ValidateJSON does
+func jsonComparable(value interface{}) interface{} {
+	value = getReferenceValue(reflect.ValueOf(value))
+	if n, ok := value.(json.Number); ok {
+		return numberOf(n)
+	}
+	return value
+}
+
+// jsonNumber passes numbers, integers only when integer is set, floats with no fraction being integers
+func jsonNumber(integer bool) Validating {
+	var (
+		rule         = CodeNumeric
+		errorMessage = "The value must be a number."
+	)
+	if integer {
+		rule, errorMessage = CodeInteger, "The value must have an integer value."
+	}
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			switch reflect.ValueOf(value).Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				return true, nil
+			case reflect.Float32, reflect.Float64:
+				f := reflect.ValueOf(value).Float()
+				return !integer || f == math.Trunc(f), nil
+			default:
+				return false, nil
+			}
+		},
+		errorMessage: errorMessage,
+		rule:         rule,
+	}
+}
+
+// jsonSchemaLength bounds the length of strings in code points, as the size rule measures strings
+func jsonSchemaLength(min bool, length int) Validating {
+	var (
+		rule         = CodeMaxLength
+		errorMessage = "The value must have a length less than or equal to the specified value."
+		compare      = func(size int) bool { return size <= length }
+	)
+	if min {
+		rule, errorMessage = CodeMinLength, "The value must have a length greater than or equal to the specified value."
+		compare = func(size int) bool { return size >= length }
+	}
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			size, err := sizeOf(value)
+			if err != nil {
+				return false, err
+			}
+			n, ok := size.(int)
+			return ok && compare(n), nil
+		},
+		errorMessage: errorMessage,
+		rule:         rule,
+		params:       map[string]interface{}{"length": length},
+	}
+}
+
+// notAllowed fails for every value, it is the false schema
+func notAllowed() Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			return false, nil
+		},
+		errorMessage: "The value is not allowed.",
+		rule:         CodeNotAllowed,
+		acceptsNil:   true,
+	}
+}
+
+// jsonKindOf returns the JSON type of a value, number for every number
+func jsonKindOf(value interface{}) string {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Invalid:
+		return "null"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.String:
+		return "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Array, reflect.Slice:
+		return "array"
+	case reflect.Map:
+		return "object"
+	default:
+		return ""
+	}
+}
+
+// jsonSchemaKeyword applies a rule to the values of a JSON type only, as keywords such as minLength ignore other types
+func jsonSchemaKeyword(kind string, validating Validating) Validating {
+	return &jsonSchemaKeywordValidator{kind: kind, validating: validating}
+}
+
+type jsonSchemaKeywordValidator struct {
+	kind       string
+	validating Validating
+}
+
+func (v *jsonSchemaKeywordValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *jsonSchemaKeywordValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	if jsonKindOf(value) != v.kind {
+		return true, nil
+	}
+	if errs := collectFieldErrors(ctx, v.validating, value, "", false); len(errs) > 0 {
+		return false, ValidationErrors(errs)
+	}
+	return true, nil
+}
+
+// Describe returns the description of the rule
+func (v *jsonSchemaKeywordValidator) Describe() RuleInfo {
+	info, _ := Describe(v.validating)
+	return info
+}
+
+// jsonSchemaObject validates the required, properties and additionalProperties keywords,
+// errors are reported under the name of the property.
+// The other JSON types pass as the keywords ignore them, while a Go value which is not a map, e.g. a struct, is a type error.
+type jsonSchemaObject struct {
+	required             []string
+	properties           map[string]Validating
+	additionalProperties Validating
+}
+
+func (v *jsonSchemaObject) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *jsonSchemaObject) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	if kind := jsonKindOf(value); isMissing(value) || len(kind) > 0 && kind != "object" {
+		return true, nil
+	}
+	elements, err := mapElementsOf(value, false)
+	if err != nil {
+		return false, err
+	}
+	ctx = ensureScope(ctx, value)
+	var (
+		errs    ValidationErrors
+		present = make(map[string]bool, len(elements))
+	)
+	for _, element := range elements {
+		present[element.key] = true
+	}
+	for _, name := range v.required {
+		if !present[name] {
+			errs = append(errs, collectFieldErrors(ctx, Required(), nil, name, false)...)
+		}
+	}
+	for _, element := range elements {
+		validating, ok := v.properties[element.key]
+		if !ok {
+			validating = v.additionalProperties
+		}
+		if validating != nil {
+			errs = append(errs, collectFieldErrors(ctx, validating, element.value, element.key, false)...)
+		}
+	}
+	if len(errs) > 0 {
+		return false, errs
+	}
+	return true, nil
+}
+
+// Describe lists the rules of the properties, params hold the required properties and the property names
+func (v *jsonSchemaObject) Describe() RuleInfo {
+	var names = make([]string, 0, len(v.properties))
+	for name := range v.properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var rules = make([]Validating, len(names))
+	for i, name := range names {
+		rules[i] = v.properties[name]
+	}
+	return RuleInfo{
+		Code:   CodePlainObject,
+		Params: map[string]interface{}{"required": v.required, "properties": names},
+		Rules:  describeAll(rules),
+	}
+}
+
+// jsonSchemaRef is a $ref, its rules are compiled after the reference so schemas may be recursive
+type jsonSchemaRef struct {
+	ref        string
+	validating Validating
+}
+
+func (v *jsonSchemaRef) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *jsonSchemaRef) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	if errs := collectFieldErrors(ctx, v.validating, value, "", false); len(errs) > 0 {
+		return false, ValidationErrors(errs)
+	}
+	return true, nil
+}
+
+// Describe does not describe the target, which may refer to the reference
+func (v *jsonSchemaRef) Describe() RuleInfo {
+	return RuleInfo{Code: CodeRef, Params: map[string]interface{}{"ref": v.ref}}
+}
@@ -0,0 +1,155 @@
+package checkit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testJSONSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "Order",
+	"type": "object",
+	"required": ["id", "items"],
+	"additionalProperties": false,
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"email": {"type": "string", "format": "email", "maxLength": 64},
+		"status": {"enum": ["new", "paid"]},
+		"code": {"type": ["string", "null"], "pattern": "^[A-Z]{3}$"},
+		"items": {"type": "array", "items": {"$ref": "#/$defs/item"}},
+		"discount": {"oneOf": [{"type": "integer", "exclusiveMaximum": 10}, {"const": "free"}]},
+		"note": {"not": {"minLength": 100}},
+		"parent": {"$ref": "#"}
+	},
+	"$defs": {
+		"item": {
+			"type": "object",
+			"required": ["price"],
+			"properties": {"price": {"type": "number", "minimum": 0}}
+		}
+	}
+}`
+
+func TestCompileJSONSchema(t *testing.T) {
+	validator, err := LoadJSONSchema(strings.NewReader(testJSONSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := `{"id": 7, "email": "a@b.co", "status": "new", "code": null, "items": [{"price": 1.5}],
+		"discount": 5, "note": "fast", "parent": {"id": 1, "items": []}}`
+	if r, err := validator.ValidateJSON(strings.NewReader(valid)); !r {
+		t.Errorf("The document must pass, got %v", err)
+	}
+	invalid := `{"id": 1.5, "email": "x", "status": "lost", "code": "ab", "items": [{"price": -1}, {}],
+		"discount": 12, "extra": true, "parent": {"items": []}}`
+	_, err = validator.ValidateJSON(strings.NewReader(invalid))
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Validation errors must be reported, got %v", err)
+	}
+	var failures []string
+	for _, fieldErr := range errs {
+		failures = append(failures, fieldErr.Pointer()+" "+fieldErr.Rule)
+	}
+	expected := []string{
+		"/code matches",
+		"/discount one_of",
+		"/email email",
+		"/extra not_allowed",
+		"/id integer",
+		"/items/0/price greater_than_equal_to",
+		"/items/1/price required",
+		"/parent/id required",
+		"/status or",
+	}
+	if !reflect.DeepEqual(failures, expected) {
+		t.Errorf("Expected %v, got %v", expected, failures)
+	}
+}
+
+func TestCompileJSONSchema_shouldListUnsupportedKeywords(t *testing.T) {
+	_, err := CompileJSONSchema([]byte(`{
+		"properties": {"a": {"format": "hostname", "multipleOf": 2}},
+		"if": {"type": "string"},
+		"$ref": "other.json"
+	}`))
+	schemaErr, ok := err.(*JSONSchemaError)
+	if !ok {
+		t.Fatalf("A JSONSchemaError must be reported, got %v", err)
+	}
+	expected := []string{"/$ref (other.json)", "/if", "/properties/a/format (hostname)", "/properties/a/multipleOf"}
+	if !reflect.DeepEqual(schemaErr.Unsupported, expected) {
+		t.Errorf("Expected %v, got %v", expected, schemaErr.Unsupported)
+	}
+	for _, document := range []string{`{"minLength": -1}`, `{"type": "date"}`, `{"$ref": "#/$defs/missing"}`, `{"items": 1}`, `[`} {
+		if _, err := CompileJSONSchema([]byte(document)); err == nil {
+			t.Errorf("%s must not compile", document)
+		}
+	}
+}
+
+func TestCompileJSONSchema_lengthAndFormats(t *testing.T) {
+	validator, err := CompileJSONSchema([]byte(`{"properties": {
+		"name": {"maxLength": 3, "minLength": 2},
+		"link": {"format": "uri"},
+		"id": {"format": "uuid"}
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, document := range []string{
+		`{"name": "héé", "link": "urn:isbn:0451450523", "id": "00000000-0000-0000-0000-000000000000"}`,
+		`{"name": "hé", "link": "ftp://example.com/a", "id": "01890a5d-ac96-774b-bcce-b302099a8057"}`,
+	} {
+		if r, err := validator.ValidateJSON(strings.NewReader(document)); !r {
+			t.Errorf("%s must pass, got %v", document, err)
+		}
+	}
+	_, err = validator.ValidateJSON(strings.NewReader(`{"name": "hééé", "link": "/relative", "id": "0000"}`))
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 3 || errs[2].Rule != CodeMaxLength {
+		t.Errorf("Three errors must be reported, got %v", err)
+	}
+}
+
+func TestCompileJSONSchema_enumAndConstShouldCompareJSONValues(t *testing.T) {
+	validator, err := CompileJSONSchema([]byte(`{"properties": {
+		"size": {"enum": [1, 2.5]},
+		"point": {"const": {"x": 1, "tags": [2, "a"]}}
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []map[string]interface{}{
+		{"size": 1, "point": map[string]interface{}{"x": 1.0, "tags": []interface{}{uint8(2), "a"}}},
+		{"size": json.Number("2.5"), "point": map[string]interface{}{"x": json.Number("1"), "tags": []interface{}{json.Number("2"), "a"}}},
+		{"size": float32(1)},
+	} {
+		if r, err := validator.ValidateSync(value); !r {
+			t.Errorf("%v must pass, got %v", value, err)
+		}
+	}
+	_, err = validator.ValidateAll(map[string]interface{}{"size": json.Number("3"), "point": map[string]interface{}{"x": 1, "tags": []interface{}{2}}})
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 2 {
+		t.Errorf("Two errors must be reported, got %v", err)
+	}
+}
+
+func TestCompileJSONSchema_whenObjectKeywordsGetStruct_shouldReturnTypeError(t *testing.T) {
+	validator, err := CompileJSONSchema([]byte(`{"required": ["id"], "properties": {"id": {"type": "integer"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []interface{}{"text", 1, nil, (*map[string]interface{})(nil)} {
+		if r, err := validator.ValidateSync(value); !r {
+			t.Errorf("%v must pass as it is not an object, got %v", value, err)
+		}
+	}
+	type order struct {
+		ID int
+	}
+	if r, err := validator.ValidateSync(order{}); r || !isInternalError(err) {
+		t.Errorf("A struct must be reported as a type error, got %v %v", r, err)
+	}
+}
@@ -3,6 +3,7 @@ package checkit
 import (
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 const (
@@ -14,6 +15,8 @@ const (
 
 type wrappedKeyedValue struct {
 	value              interface{}
+	keyPath            string
+	absent             bool // the key path does not lead to a value, unlike an explicit nil
 	shouldValidateNorm bool
 	shouldValidateAny  bool
 	shouldValidateAll  bool
@@ -22,43 +25,71 @@ type wrappedKeyedValue struct {
 }
 
 func getValueForKey(key string, obj interface{}) interface{} {
+	value, _ := lookupValueForKey(key, obj)
+	return value
+}
+
+// lookupValueForKey reports whether the key exists, so that a missing key can be told apart from a nil value
+func lookupValueForKey(key string, obj interface{}) (interface{}, bool) {
+	if values, ok := lookupMultiValue(key, obj); ok {
+		if len(values) == 0 {
+			return nil, false
+		}
+		return values, true
+	}
 	objValue := reflect.ValueOf(obj)
 	switch objValue.Kind() {
 	case reflect.Array, reflect.Slice:
 		switch key {
 		case keyAll, keyAny:
-			return obj
+			return obj, true
 		case keyFirst:
-			return getReferenceValue(objValue.Index(0))
+			return indexValue(objValue, 0)
 		case keyLast:
-			return getReferenceValue(objValue.Index(objValue.Len() - 1))
+			return indexValue(objValue, objValue.Len()-1)
 		default:
 			if intValue, err := strconv.Atoi(key); err == nil {
-				return getReferenceValue(objValue.Index(intValue))
+				return indexValue(objValue, intValue)
 			}
-			return nil
+			return nil, false
 		}
 	case reflect.Map:
 		mapKeys := objValue.MapKeys()
 		if len(mapKeys) == 0 {
-			return nil
+			return nil, false
 		}
 		reflectValueOfKey := getReflectKeyInMapKeys(mapKeys, key)
+		if !reflectValueOfKey.IsValid() {
+			return nil, false
+		}
 		reflectValueOfValue := objValue.MapIndex(reflectValueOfKey)
 		if reflectValueOfValue.Kind() == reflect.Invalid {
-			return nil
+			return nil, false
 		}
-		return reflectValueOfValue.Interface()
+		return reflectValueOfValue.Interface(), true
 	case reflect.Interface, reflect.Ptr:
 		objValue = flattenReflectValue(objValue)
+		if objValue.Kind() != reflect.Struct {
+			return nil, false
+		}
 		fallthrough
 	case reflect.Struct:
 		fieldByName := objValue.FieldByName(key)
-		return getReferenceValue(fieldByName)
+		if !fieldByName.IsValid() {
+			return nil, false
+		}
+		return getReferenceValue(fieldByName), true
 	default:
 		break
 	}
-	return nil
+	return nil, false
+}
+
+func indexValue(arrValue reflect.Value, index int) (interface{}, bool) {
+	if index < 0 || index >= arrValue.Len() {
+		return nil, false
+	}
+	return getReferenceValue(arrValue.Index(index)), true
 }
 
 func getReflectKeyInMapKeys(mapKeys []reflect.Value, key string) reflect.Value {
@@ -195,12 +226,23 @@ func buildWrappedKeyValueWithKeys(keys []string, keyIndex int, value interface{}
 		return
 	}
 	key := keys[keyIndex]
-	keyedValue := getValueForKey(key, value)
+	keyedValue, ok := lookupValueForKey(key, value)
 	if keyedValue == nil {
 		parent.value = nil
+		parent.absent = !ok || keyIndex < len(keys)-1
+		parent.keyPath = joinKeyPath(parent.keyPath, strings.Join(keys[keyIndex:], "."))
 		return
 	}
 	switch key {
+	case keyAny, keyAll:
+		if kind := reflect.ValueOf(keyedValue).Kind(); kind != reflect.Array && kind != reflect.Slice {
+			parent.value = nil
+			parent.absent = true
+			parent.keyPath = joinKeyPath(parent.keyPath, strings.Join(keys[keyIndex:], "."))
+			return
+		}
+	}
+	switch key {
 	case keyAny:
 		parent.shouldValidateAny = true
 		parent.shouldValidateAll = false
@@ -210,6 +252,7 @@ func buildWrappedKeyValueWithKeys(keys []string, keyIndex int, value interface{}
 		for i := 0; i < arrValue.Len(); i++ {
 			el := getReferenceValue(arrValue.Index(i))
 			newWrappedKeyedValue := makeNormalWrappedKeyedValue(el, parent)
+			newWrappedKeyedValue.keyPath = joinKeyPath(parent.keyPath, strconv.Itoa(i))
 			buildWrappedKeyValueWithKeys(keys, keyIndex+1, el, newWrappedKeyedValue)
 		}
 	case keyAll:
@@ -221,10 +264,55 @@ func buildWrappedKeyValueWithKeys(keys []string, keyIndex int, value interface{}
 		for i := 0; i < arrValue.Len(); i++ {
 			el := getReferenceValue(arrValue.Index(i))
 			newWrappedKeyedValue := makeNormalWrappedKeyedValue(el, parent)
+			newWrappedKeyedValue.keyPath = joinKeyPath(parent.keyPath, strconv.Itoa(i))
 			buildWrappedKeyValueWithKeys(keys, keyIndex+1, el, newWrappedKeyedValue)
 		}
 	default:
 		newWrappedKeyedValue := makeNormalWrappedKeyedValue(keyedValue, parent)
+		newWrappedKeyedValue.keyPath = joinKeyPath(parent.keyPath, resolveKey(key, value))
 		buildWrappedKeyValueWithKeys(keys, keyIndex+1, keyedValue, newWrappedKeyedValue)
 	}
 }
+
+// resolveKey returns the concrete key for first and last, so that error key paths point at an index
+func resolveKey(key string, obj interface{}) string {
+	objValue := reflect.ValueOf(obj)
+	switch objValue.Kind() {
+	case reflect.Array, reflect.Slice:
+		switch key {
+		case keyFirst:
+			return "0"
+		case keyLast:
+			return strconv.Itoa(objValue.Len() - 1)
+		}
+	}
+	return key
+}
+
+func joinKeyPath(keyPath string, key string) string {
+	if len(keyPath) == 0 {
+		return key
+	}
+	return keyPath + "." + key
+}
+
+func splitKeyPath(keyPath string) []string {
+	var keys []string = []string{}
+	for _, k := range strings.Split(keyPath, ".") {
+		if len(k) > 0 {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// valueForKeyPath follows a key path from the value, all and any return the collection itself
+func valueForKeyPath(value interface{}, keyPath string) interface{} {
+	for _, key := range splitKeyPath(keyPath) {
+		if value == nil {
+			return nil
+		}
+		value = getValueForKey(key, value)
+	}
+	return value
+}
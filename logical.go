@@ -0,0 +1,156 @@
+package checkit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// Or passes when at least one of the rules passes
+func Or(validatings ...Validating) Validating {
+	return &logicalValidator{
+		branches:     validatings,
+		minPassed:    1,
+		maxPassed:    -1,
+		rule:         CodeOr,
+		errorMessage: "The value must pass at least one of the rules.",
+	}
+}
+
+// AnyOf is the same as Or
+func AnyOf(validatings ...Validating) Validating {
+	return Or(validatings...)
+}
+
+// Not passes when the rule fails
+func Not(validating Validating) Validating {
+	return &logicalValidator{
+		branches:     []Validating{validating},
+		minPassed:    0,
+		maxPassed:    0,
+		rule:         CodeNot,
+		errorMessage: "The value must not pass the rule.",
+	}
+}
+
+// XOr passes when exactly one of the two rules passes
+func XOr(lhs Validating, rhs Validating) Validating {
+	return &logicalValidator{
+		branches:     []Validating{lhs, rhs},
+		minPassed:    1,
+		maxPassed:    1,
+		rule:         CodeXOr,
+		errorMessage: "The value must pass exactly one of the two rules.",
+	}
+}
+
+// OneOf passes when exactly one of the rules passes
+func OneOf(validatings ...Validating) Validating {
+	return &logicalValidator{
+		branches:     validatings,
+		minPassed:    1,
+		maxPassed:    1,
+		rule:         CodeOneOf,
+		errorMessage: "The value must pass exactly one of the rules.",
+	}
+}
+
+// AtLeast passes when n or more of the rules pass
+func AtLeast(n int, validatings ...Validating) Validating {
+	return &logicalValidator{
+		branches:     validatings,
+		minPassed:    n,
+		maxPassed:    -1,
+		rule:         CodeAtLeast,
+		params:       map[string]interface{}{"n": n},
+		errorMessage: "The value must pass at least n of the rules.",
+	}
+}
+
+// BranchErrors explains the failure of a logical rule such as Or or OneOf,
+// it holds the errors of each rule by position, nil for the rules which passed or were not evaluated.
+// It is found in the Err of the FieldError reported by the logical rule.
+type BranchErrors []ValidationErrors
+
+func (e BranchErrors) Error() string {
+	var messages []string
+	for i, errs := range e {
+		if len(errs) > 0 {
+			messages = append(messages, "rule "+strconv.Itoa(i)+": "+errs.Error())
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap exposes the field errors of every branch to errors.Is and errors.As
+func (e BranchErrors) Unwrap() []error {
+	var errs []error
+	for _, branchErrs := range e {
+		errs = append(errs, branchErrs.Unwrap()...)
+	}
+	return errs
+}
+
+// logicalValidator passes when the number of passing branches lies between minPassed and maxPassed,
+// a negative maxPassed has no upper bound
+type logicalValidator struct {
+	branches     []Validating
+	minPassed    int
+	maxPassed    int
+	rule         string
+	params       map[string]interface{}
+	errorMessage string
+}
+
+func (v *logicalValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *logicalValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	var branchErrs = make(BranchErrors, len(v.branches))
+	var passed []int
+	for i, branch := range v.branches {
+		if errs := collectFieldErrors(ctx, branch, value, "", false); len(errs) > 0 {
+			if err := branchErrorOf(errs); err != nil {
+				return false, err
+			}
+			branchErrs[i] = errs
+			continue
+		}
+		passed = append(passed, i)
+		if v.maxPassed < 0 && len(passed) >= v.minPassed {
+			return true, nil
+		}
+		if v.maxPassed >= 0 && len(passed) > v.maxPassed {
+			break
+		}
+	}
+	if len(passed) >= v.minPassed && (v.maxPassed < 0 || len(passed) <= v.maxPassed) {
+		return true, nil
+	}
+	var params = map[string]interface{}{"passed": passed}
+	for key, param := range v.params {
+		params[key] = param
+	}
+	fieldErr := &FieldError{
+		Rule:    v.rule,
+		Params:  params,
+		Value:   value,
+		Message: v.errorMessage,
+	}
+	if len(passed) < v.minPassed {
+		fieldErr.Err = branchErrs
+	}
+	return false, fieldErr
+}
+
+// branchErrorOf returns the error which stops a logical rule rather than failing a branch:
+// a type mismatch, a panic, a lookup failure or an error which is not a rule failure, e.g. the end of the context
+func branchErrorOf(errs []*FieldError) *FieldError {
+	for _, err := range errs {
+		if isInternalError(err) || len(err.Rule) == 0 && err.Err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package checkit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOr(t *testing.T) {
+	validating := Or(Ipv4(), MustMatches(`^[a-z.]+$`))
+	for _, value := range []interface{}{"127.0.0.1", "example.com"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	_, err := validating.Validate("Example.com")
+	var branchErrs BranchErrors
+	if !errors.As(err, &branchErrs) || len(branchErrs) != 2 || len(branchErrs[0]) != 1 || len(branchErrs[1]) != 1 {
+		t.Fatalf("Error must explain every failing rule, got %v", err)
+	}
+	if branchErrs[0][0].Rule != "ipv4" || branchErrs[1][0].Rule != "matches" {
+		t.Errorf("Error must report the rule of each branch, got %v", branchErrs)
+	}
+}
+
+func TestNot(t *testing.T) {
+	if r, _ := Not(Empty()).Validate("a"); !r {
+		t.Fail()
+	}
+	_, err := Not(Empty()).Validate("")
+	if fieldErr, ok := err.(*FieldError); !ok || fieldErr.Rule != "not" {
+		t.Errorf("Error must be reported by not, got %v", err)
+	}
+}
+
+func TestXOrAndOneOf(t *testing.T) {
+	if r, _ := XOr(Min(1), Max(0)).Validate(2); !r {
+		t.Fail()
+	}
+	_, err := OneOf(Min(1), Min(2), Max(0)).Validate(2)
+	fieldErr, ok := err.(*FieldError)
+	if !ok || !reflect.DeepEqual(fieldErr.Params["passed"], []int{0, 1}) {
+		t.Errorf("Error must report the passing rules, got %v", err)
+	}
+	if r, _ := OneOf(Min(1), Min(2), Max(0)).Validate(1); !r {
+		t.Fail()
+	}
+}
+
+func TestAtLeast(t *testing.T) {
+	validating := AtLeast(2, Alpha(), MinLength(3), MaxLength(1))
+	if r, _ := validating.Validate("abc"); !r {
+		t.Fail()
+	}
+	_, err := validating.Validate("a1")
+	if fieldErr, ok := err.(*FieldError); !ok || fieldErr.Params["n"] != 2 {
+		t.Errorf("Error must report n, got %v", err)
+	}
+}
+
+func TestNot_shouldPropagateInternalErrors(t *testing.T) {
+	if r, err := Not(Finite()).Validate("a"); r || !isInternalError(err) {
+		t.Errorf("A type mismatch must not pass, got %v", err)
+	}
+	if r, err := Not(panickingRule{}).Validate("a"); r || !isInternalError(err) {
+		t.Errorf("A panic must not pass, got %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r, err := Not(Email()).(ValidatingContext).ValidateContext(ctx, "a"); r || !errors.Is(err, context.Canceled) {
+		t.Errorf("The end of the context must not pass, got %v", err)
+	}
+}
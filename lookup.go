@@ -0,0 +1,186 @@
+package checkit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// Lookup tells whether a key is known by a backend, e.g. a username in a users table
+type Lookup interface {
+	Exists(ctx context.Context, key interface{}) (bool, error)
+}
+
+// LookupFunc adapts a function to a Lookup
+type LookupFunc func(ctx context.Context, key interface{}) (bool, error)
+
+// Exists ...
+func (f LookupFunc) Exists(ctx context.Context, key interface{}) (bool, error) {
+	return f(ctx, key)
+}
+
+// Unique passes when the lookup does not know the value, e.g. a username which is not taken yet
+func Unique(lookup Lookup) Validating {
+	return &contextValidator{
+		validator: validator{
+			errorMessage: "The value has already been taken.",
+			rule:         CodeUnique,
+		},
+		validateContextFunc: func(ctx context.Context, value interface{}) (bool, error) {
+			if isMissing(value) {
+				return false, ErrValueMissing
+			}
+			exists, err := lookupExists(ctx, lookup, value)
+			if err != nil {
+				return false, asLookupError(err)
+			}
+			return !exists, nil
+		},
+	}
+}
+
+// Exists passes when the lookup knows the value, e.g. a referenced account id
+func Exists(lookup Lookup) Validating {
+	return &contextValidator{
+		validator: validator{
+			errorMessage: "The value does not exist.",
+			rule:         CodeExists,
+		},
+		validateContextFunc: func(ctx context.Context, value interface{}) (bool, error) {
+			if isMissing(value) {
+				return false, ErrValueMissing
+			}
+			exists, err := lookupExists(ctx, lookup, value)
+			if err != nil {
+				return false, asLookupError(err)
+			}
+			return exists, nil
+		},
+	}
+}
+
+// WithLookupCache returns a context in which every Unique and Exists check asks the backend
+// at most once per lookup and key, so the checks of a request are batched together.
+// Validate attaches such a cache to its context when there is none.
+func WithLookupCache(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(lookupCacheKey{}).(*lookupCache); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, lookupCacheKey{}, &lookupCache{
+		entries: make(map[lookupCacheEntryKey]*lookupCacheEntry),
+	})
+}
+
+type lookupCacheKey struct{}
+
+type lookupCacheEntryKey struct {
+	lookup Lookup
+	key    interface{}
+}
+
+type lookupCacheEntry struct {
+	done   chan struct{}
+	exists bool
+	err    error
+}
+
+type lookupCache struct {
+	mutex   sync.Mutex
+	entries map[lookupCacheEntryKey]*lookupCacheEntry
+}
+
+func lookupExists(ctx context.Context, lookup Lookup, key interface{}) (exists bool, err error) {
+	cache, ok := ctx.Value(lookupCacheKey{}).(*lookupCache)
+	if !ok || !isHashable(lookup) || !isHashable(key) {
+		return lookup.Exists(ctx, key)
+	}
+	entry, found := cache.entryOf(lookupCacheEntryKey{lookup: lookup, key: key})
+	if !found {
+		defer close(entry.done)
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				entry.exists, entry.err = false, &PanicError{Recovered: recovered}
+				exists, err = entry.exists, entry.err
+			}
+		}()
+		entry.exists, entry.err = lookup.Exists(ctx, key)
+		return entry.exists, entry.err
+	}
+	select {
+	case <-entry.done:
+		return entry.exists, entry.err
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+// entryOf returns the entry of the key, found is false when the entry has just been added
+func (c *lookupCache) entryOf(key lookupCacheEntryKey) (entry *lookupCacheEntry, found bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, found = c.entries[key]
+	if !found {
+		entry = &lookupCacheEntry{done: make(chan struct{})}
+		c.entries[key] = entry
+	}
+	return entry, found
+}
+
+// asLookupError wraps the error of a backend, a panic stays a PanicError
+func asLookupError(err error) error {
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		return err
+	}
+	return &LookupError{Err: err}
+}
+
+// isHashable reports whether the value can be a map key, interfaces held by the value are checked as well
+func isHashable(v interface{}) bool {
+	return v != nil && reflect.ValueOf(v).Comparable()
+}
+
+// MemoryLookup is a Lookup over an in-memory set of keys, it is safe for concurrent use
+type MemoryLookup struct {
+	mutex sync.RWMutex
+	keys  map[interface{}]struct{}
+}
+
+// NewMemoryLookup returns a MemoryLookup holding the keys
+func NewMemoryLookup(keys ...interface{}) *MemoryLookup {
+	var l = &MemoryLookup{
+		keys: make(map[interface{}]struct{}, len(keys)),
+	}
+	l.Add(keys...)
+	return l
+}
+
+// Add ...
+func (l *MemoryLookup) Add(keys ...interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for _, key := range keys {
+		l.keys[key] = struct{}{}
+	}
+}
+
+// Remove ...
+func (l *MemoryLookup) Remove(keys ...interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for _, key := range keys {
+		delete(l.keys, key)
+	}
+}
+
+// Exists ...
+func (l *MemoryLookup) Exists(ctx context.Context, key interface{}) (bool, error) {
+	if !isHashable(key) {
+		return false, nil
+	}
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	_, ok := l.keys[key]
+	return ok, nil
+}
@@ -0,0 +1,209 @@
+package checkit
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingLookup struct {
+	calls int32
+	keys  map[interface{}]bool
+}
+
+func (l *countingLookup) Exists(ctx context.Context, key interface{}) (bool, error) {
+	atomic.AddInt32(&l.calls, 1)
+	return l.keys[key], nil
+}
+
+func TestUnique(t *testing.T) {
+	lookup := NewMemoryLookup("alice")
+	if r, _ := Unique(lookup).Validate("bob"); !r {
+		t.Errorf("%q must be unique", "bob")
+	}
+	_, err := Unique(lookup).Validate("alice")
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Rule != "unique" {
+		t.Errorf("%q must be taken, got %v", "alice", err)
+	}
+}
+
+func TestExists(t *testing.T) {
+	lookup := NewMemoryLookup(1, 2)
+	if r, _ := Exists(lookup).Validate(1); !r {
+		t.Errorf("%d must exist", 1)
+	}
+	lookup.Remove(1)
+	if r, _ := Exists(lookup).Validate(1); r {
+		t.Errorf("%d must not exist", 1)
+	}
+}
+
+func TestValidate_shouldBatchLookupsPerCall(t *testing.T) {
+	lookup := &countingLookup{keys: map[interface{}]bool{"a": true}}
+	validator := Validator{
+		"accounts.all": Exists(lookup),
+		"owner":        Exists(lookup),
+	}
+	value := map[string]interface{}{
+		"accounts": []string{"a", "a", "b"},
+		"owner":    "a",
+	}
+	_, err := validator.Validate(context.Background(), value)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].KeyPath != "accounts.2" {
+		t.Errorf("Only %q must fail, got %v", "accounts.2", err)
+	}
+	if lookup.calls != 2 {
+		t.Errorf("Backend must be asked once per key, got %d calls", lookup.calls)
+	}
+}
+
+// absentLookup knows no key and never hashes them
+type absentLookup struct {
+	calls int32
+}
+
+func (l *absentLookup) Exists(ctx context.Context, key interface{}) (bool, error) {
+	atomic.AddInt32(&l.calls, 1)
+	return false, nil
+}
+
+func TestExists_whenKeyIsNotHashable_shouldAskTheBackend(t *testing.T) {
+	type key struct{ ids interface{} }
+	lookup := &absentLookup{}
+	ctx := WithLookupCache(context.Background())
+	for i := 0; i < 2; i++ {
+		if r, _ := Exists(lookup).(ValidatingContext).ValidateContext(ctx, key{ids: []int{1}}); r {
+			t.Errorf("An unknown key must not exist")
+		}
+	}
+	if lookup.calls != 2 {
+		t.Errorf("A key which is not hashable must not be cached, got %d calls", lookup.calls)
+	}
+}
+
+func TestUnique_whenValueIsMissing_shouldNotAskTheBackend(t *testing.T) {
+	lookup := &countingLookup{}
+	for _, value := range []interface{}{nil, (*string)(nil)} {
+		if _, err := Unique(lookup).Validate(value); !errors.Is(err, ErrValueMissing) {
+			t.Errorf("A nil value must be reported as missing, got %v", err)
+		}
+	}
+	if lookup.calls != 0 {
+		t.Errorf("Backend must not be asked, got %d calls", lookup.calls)
+	}
+}
+
+func TestUnique_shouldReturnLookupErrors(t *testing.T) {
+	lookup := LookupFunc(func(ctx context.Context, key interface{}) (bool, error) {
+		return false, errors.New("connection refused")
+	})
+	validator := Validator{"username": Unique(lookup)}
+	value := map[string]interface{}{"username": "alice"}
+	for _, validate := range []func() (bool, error){
+		func() (bool, error) { return validator.Validate(context.Background(), value) },
+		func() (bool, error) { return validator.ValidateAll(value) },
+		func() (bool, error) { return validator.ValidateSync(value) },
+		func() (bool, error) { return validator.MayBeSync(value) },
+		func() (bool, error) { return Validator{"username": Not(Exists(lookup))}.ValidateAll(value) },
+	} {
+		r, err := validate()
+		var lookupErr *LookupError
+		if _, ok := err.(*LookupError); r || !ok || !errors.As(err, &lookupErr) || lookupErr.Err.Error() != "connection refused" {
+			t.Errorf("The LookupError must be returned, got %v", err)
+		}
+	}
+}
+
+type panickingLookup struct{}
+
+func (l *panickingLookup) Exists(ctx context.Context, key interface{}) (bool, error) {
+	panic("backend failure")
+}
+
+func TestValidate_shouldReleaseWaitersWhenLookupPanics(t *testing.T) {
+	lookup := &panickingLookup{}
+	validator := Validator{"a": Unique(lookup), "b": Unique(lookup)}
+	done := make(chan error)
+	go func() {
+		_, err := validator.Validate(context.Background(), map[string]interface{}{"a": "x", "b": "x"})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		errs, ok := err.(ValidationErrors)
+		if !ok || len(errs) != 2 {
+			t.Fatalf("Both key paths must fail, got %v", err)
+		}
+		for _, fieldErr := range errs {
+			var panicErr *PanicError
+			if !errors.As(fieldErr, &panicErr) {
+				t.Errorf("A PanicError must be reported, got %v", fieldErr)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Validate must not wait for a panicking lookup")
+	}
+}
+
+func TestSQLLookup(t *testing.T) {
+	db := sql.OpenDB(fakeConnector{keys: map[string]bool{"alice": true}})
+	defer db.Close()
+	lookup := NewSQLLookup(db, "SELECT 1 FROM users WHERE username = ?")
+	if exists, err := lookup.Exists(context.Background(), "alice"); !exists || err != nil {
+		t.Errorf("%q must exist, got %v", "alice", err)
+	}
+	if exists, err := lookup.Exists(context.Background(), "bob"); exists || err != nil {
+		t.Errorf("%q must not exist, got %v", "bob", err)
+	}
+}
+
+type fakeConnector struct {
+	keys map[string]bool
+}
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn(c), nil }
+func (c fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct {
+	keys map[string]bool
+}
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt(c), nil }
+func (c fakeConn) Close() error                              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	keys map[string]bool
+}
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return 1 }
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	key, _ := args[0].(string)
+	return &fakeRows{remaining: s.keys[key]}, nil
+}
+
+type fakeRows struct {
+	remaining bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"1"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if !r.remaining {
+		return io.EOF
+	}
+	r.remaining = false
+	dest[0] = int64(1)
+	return nil
+}
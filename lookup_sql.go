@@ -0,0 +1,42 @@
+package checkit
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SQLQueryer is implemented by *sql.DB, *sql.Tx and *sql.Conn
+type SQLQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// SQLLookup is a Lookup running a query with the key as its only argument,
+// the key exists when the query returns at least one row
+//
+//	NewSQLLookup(db, "SELECT 1 FROM users WHERE username = $1")
+type SQLLookup struct {
+	db    SQLQueryer
+	query string
+}
+
+// NewSQLLookup ...
+func NewSQLLookup(db SQLQueryer, query string) *SQLLookup {
+	return &SQLLookup{
+		db:    db,
+		query: query,
+	}
+}
+
+// Exists ...
+func (l *SQLLookup) Exists(ctx context.Context, key interface{}) (bool, error) {
+	rows, err := l.db.QueryContext(ctx, l.query, key)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	exists := rows.Next()
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
@@ -0,0 +1,71 @@
+package checkit
+
+import (
+	"context"
+	"strings"
+	"text/template"
+)
+
+// MessageData is available to message templates, see WithMessage
+type MessageData struct {
+	Label   string
+	KeyPath string
+	Rule    string
+	Value   interface{}
+	Params  map[string]interface{}
+}
+
+// WithMessage replaces the message of the errors reported by the rule.
+// The message is a text/template executed with MessageData, the label is set by WithLabel
+// and defaults to the key path. It panics when the message is not a valid template.
+//
+//	"name": WithMessage(MinLength(3), "{{.Label}} must have at least {{.Params.length}} characters.")
+func WithMessage(validating Validating, message string) Validating {
+	return &messageValidator{
+		validating: validating,
+		template:   template.Must(template.New("message").Option("missingkey=zero").Parse(message)),
+	}
+}
+
+type messageValidator struct {
+	validating Validating
+	template   *template.Template
+}
+
+func (v *messageValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *messageValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	errs := collectFieldErrors(ctx, v.validating, value, "", false)
+	if len(errs) == 0 {
+		return true, nil
+	}
+	scope, o := scopeOf(ctx, value), optionsOf(ctx)
+	for i, fieldErr := range errs {
+		keyPath := scope.keyPath
+		if len(fieldErr.KeyPath) > 0 {
+			keyPath = joinKeyPath(keyPath, fieldErr.KeyPath)
+		}
+		rendered := *fieldErr
+		rendered.Message = renderMessage(v.template, MessageData{
+			Label:   o.labelFor(keyPath),
+			KeyPath: keyPath,
+			Rule:    fieldErr.Rule,
+			Value:   fieldErr.Value,
+			Params:  fieldErr.Params,
+		}, fieldErr.Message)
+		rendered.customMessage = true
+		errs[i] = &rendered
+	}
+	return false, ValidationErrors(errs)
+}
+
+// renderMessage falls back to the given message when the template fails
+func renderMessage(tmpl *template.Template, data MessageData, fallback string) string {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return fallback
+	}
+	return sb.String()
+}
@@ -0,0 +1,65 @@
+package checkit
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWithMessage(t *testing.T) {
+	validator := Validator{
+		"name":            WithMessage(MinLength(3), "{{.Label}} must have at least {{.Params.length}} characters, got {{.Value}}."),
+		"items.all.price": WithMessage(Min(1), "{{.Label}} must be at least {{.Params.min}}."),
+	}
+	value := map[string]interface{}{
+		"name":  "ab",
+		"items": []map[string]int{{"price": 1}, {"price": 0}},
+	}
+	_, err := validator.ValidateAll(value, WithLabel("items.all.price", "Price"))
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Two errors must be reported, got %v", err)
+	}
+	if errs[0].KeyPath != "items.1.price" || errs[0].Message != "Price must be at least 1." || errs[0].Rule != "min" {
+		t.Errorf("The declared label must be used, got %v", errs[0])
+	}
+	if errs[1].Message != "name must have at least 3 characters, got ab." {
+		t.Errorf("The label must default to the key path, got %v", errs[1])
+	}
+	_, err = validator.ValidateSync(value, WithLabel("name", "Name"), WithLabel("items.1.price", "Second price"))
+	if fieldErr, ok := err.(*FieldError); !ok || fieldErr.Message != "Second price must be at least 1." {
+		t.Errorf("The resolved label must be used, got %v", err)
+	}
+}
+
+func TestWithMessage_whenStruct_shouldUseLabel(t *testing.T) {
+	type user struct {
+		Email string
+	}
+	_, err := Validator{"Email": WithMessage(Email(), "{{.Label}} is not valid.")}.ValidateSync(user{Email: "x"}, WithLabel("Email", "Email address"))
+	if err == nil || err.Error() != "Email: Email address is not valid." {
+		t.Errorf("The message must be rendered, got %v", err)
+	}
+}
+
+func TestWithLabel_shouldNameTheValueOfDefaultMessages(t *testing.T) {
+	validator := Validator{"email": Email(), "name": MinLength(3)}
+	value := map[string]interface{}{"email": "x", "name": "ab"}
+	_, err := validator.ValidateAll(value, WithLabel("email", "Email address"))
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Two errors must be reported, got %v", err)
+	}
+	if !strings.HasPrefix(errs[0].Message, "Email address must") {
+		t.Errorf("The label must name the value, got %v", errs[0].Message)
+	}
+	if !strings.HasPrefix(errs[1].Message, "The value must") {
+		t.Errorf("A value without label must keep the default message, got %v", errs[1].Message)
+	}
+	type user struct {
+		Email string `checkit:"email"`
+	}
+	_, err = ValidateStruct(user{Email: "x"}, WithLabel("Email", "Email address"))
+	if errs, ok := err.(ValidationErrors); !ok || !strings.HasPrefix(errs[0].Message, "Email address must") {
+		t.Errorf("The label must name the value of a struct field, got %v", err)
+	}
+}
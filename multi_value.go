@@ -0,0 +1,198 @@
+package checkit
+
+import (
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// multiValue holds the values of a parameter of url.Values, http.Header or multipart.Form.
+// Rules are given its first value, except the rules validating lists such as Each, Contains or AllValues
+// which are given every value, and the rules combining other rules such as Or which pass it on.
+type multiValue []interface{}
+
+type multiValueMode int
+
+const (
+	firstValue multiValueMode = iota
+	allValues
+	passValues
+)
+
+// multiValueRule is implemented by the rules which are not given the first value of a multi-value parameter
+type multiValueRule interface {
+	multiValueMode() multiValueMode
+}
+
+func (m multiValue) valueFor(validating Validating) interface{} {
+	var mode = firstValue
+	if rule, ok := validating.(multiValueRule); ok {
+		mode = rule.multiValueMode()
+	}
+	switch mode {
+	case allValues:
+		return []interface{}(m)
+	case passValues:
+		return m
+	default:
+		if len(m) == 0 {
+			return nil
+		}
+		return m[0]
+	}
+}
+
+func firstValueOf(value interface{}) interface{} {
+	if values, ok := value.(multiValue); ok {
+		return values.valueFor(nil)
+	}
+	return value
+}
+
+// lookupMultiValue returns the values of a parameter, header names are matched case-insensitively
+// and the values of a multipart form come before its files
+func lookupMultiValue(key string, obj interface{}) (multiValue, bool) {
+	switch o := obj.(type) {
+	case url.Values:
+		return stringsToMultiValue(o[key]), true
+	case http.Header:
+		if values, ok := o[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+			return stringsToMultiValue(values), true
+		}
+		for name, values := range o {
+			if strings.EqualFold(name, key) {
+				return stringsToMultiValue(values), true
+			}
+		}
+		return nil, true
+	case multipart.Form:
+		return formMultiValue(&o, key), true
+	case *multipart.Form:
+		if o == nil {
+			return nil, true
+		}
+		return formMultiValue(o, key), true
+	default:
+		return nil, false
+	}
+}
+
+func formMultiValue(form *multipart.Form, key string) multiValue {
+	values := stringsToMultiValue(form.Value[key])
+	for _, file := range form.File[key] {
+		values = append(values, file)
+	}
+	return values
+}
+
+func stringsToMultiValue(values []string) multiValue {
+	if len(values) == 0 {
+		return nil
+	}
+	var m = make(multiValue, len(values))
+	for i, value := range values {
+		m[i] = value
+	}
+	return m
+}
+
+// AllValues gives every value of a multi-value parameter of url.Values, http.Header or multipart.Form to the rule,
+// e.g. AllValues(MaxLength(3)) allows at most 3 values. Other values are validated unchanged.
+func AllValues(validating Validating) Validating {
+	return &allValuesValidator{validating: validating}
+}
+
+type allValuesValidator struct {
+	validating Validating
+}
+
+func (v *allValuesValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *allValuesValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	if errs := collectFieldErrors(ctx, v.validating, value, "", false); len(errs) > 0 {
+		return false, ValidationErrors(errs)
+	}
+	return true, nil
+}
+
+// AsNumber converts a string, such as a query parameter, into a number before validating it with the rule.
+// Integers become int64 and the other numbers float64, a string which is not a number fails with CodeNumeric.
+//
+//	"page": AsNumber(Between(1, 100))
+func AsNumber(validating Validating) Validating {
+	return &numberValidator{validating: validating}
+}
+
+type numberValidator struct {
+	validating Validating
+}
+
+func (v *numberValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *numberValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	if s, ok := value.(string); ok {
+		number, err := parseNumber(strings.TrimSpace(s))
+		if err != nil {
+			return false, &FieldError{
+				Rule:    CodeNumeric,
+				Value:   value,
+				Message: "The value must be a number.",
+			}
+		}
+		value = number
+	}
+	if errs := collectFieldErrors(ctx, v.validating, value, "", false); len(errs) > 0 {
+		return false, ValidationErrors(errs)
+	}
+	return true, nil
+}
+
+func parseNumber(s string) (interface{}, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func (v *validator) multiValueMode() multiValueMode {
+	if v.allValues {
+		return allValues
+	}
+	return firstValue
+}
+
+func (v *collectionValidator) multiValueMode() multiValueMode {
+	return allValues
+}
+
+func (v *allValuesValidator) multiValueMode() multiValueMode {
+	return allValues
+}
+
+func (c CompoundValidating) multiValueMode() multiValueMode {
+	return passValues
+}
+
+func (v *presenceValidator) multiValueMode() multiValueMode {
+	return passValues
+}
+
+func (v *logicalValidator) multiValueMode() multiValueMode {
+	return passValues
+}
+
+func (v *messageValidator) multiValueMode() multiValueMode {
+	return passValues
+}
+
+func (v *conditionalValidator) multiValueMode() multiValueMode {
+	return passValues
+}
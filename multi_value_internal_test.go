@@ -0,0 +1,90 @@
+package checkit
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestURLValues(t *testing.T) {
+	query := url.Values{
+		"q":    {"golang", "x"},
+		"tags": {"a", "b", "c"},
+		"page": {"2"},
+	}
+	validator := Validator{
+		"q":         MinLength(3),
+		"tags":      CompoundValidating{Each(MaxLength(1)), Contains("b"), AllValues(MaxLength(3))},
+		"tags.last": Equals("c"),
+		"page":      AsNumber(Between(1, 10)),
+		"sort":      Optional(String()),
+	}
+	if r, err := validator.ValidateAll(query); !r {
+		t.Errorf("The query must pass, got %v", err)
+	}
+	query.Set("page", "eleven")
+	query["tags"] = append(query["tags"], "dd")
+	_, err := validator.ValidateAll(query)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 4 {
+		t.Fatalf("Four errors must be reported, got %v", err)
+	}
+	if errs[0].KeyPath != "page" || errs[0].Rule != CodeNumeric ||
+		errs[1].KeyPath != "tags.3" || errs[1].Rule != CodeMaxLength ||
+		errs[2].KeyPath != "tags" || errs[2].Rule != CodeMaxLength ||
+		errs[3].KeyPath != "tags.3" || errs[3].Rule != CodeEquals {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+	if r, _ := (Validator{"q": Required()}).ValidateSync(url.Values{"q": {}}); r {
+		t.Errorf("A parameter without values must be missing")
+	}
+}
+
+func TestHTTPHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header["x-request-id"] = []string{"42"}
+	validator := Validator{
+		"content-type": Equals("application/json"),
+		"X-Request-Id": AsNumber(GreaterThan(0)),
+		"Accept":       Optional(String()),
+	}
+	if r, err := validator.ValidateAll(header); !r {
+		t.Errorf("Header names must be matched case-insensitively, got %v", err)
+	}
+	if r, _ := (Validator{"content-type": RequiredIf("CONTENT-TYPE", "application/json")}).ValidateSync(header); !r {
+		t.Errorf("Resolved parameters must be their first value")
+	}
+}
+
+func TestMultipartForm(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("title", "report")
+	part, _ := writer.CreateFormFile("attachment", "a.txt")
+	part.Write([]byte("hello"))
+	writer.Close()
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer form.RemoveAll()
+	validator := Validator{
+		"title":      MinLength(3),
+		"attachment": Max(5),
+	}
+	if r, err := validator.ValidateAll(form); !r {
+		t.Errorf("The form must pass, got %v", err)
+	}
+	if r, _ := (Validator{"attachment": Max(4)}).ValidateSync(form); r {
+		t.Errorf("The file must be too large")
+	}
+	if r, err := validator.ValidateAll(*form); !r {
+		t.Errorf("A multipart.Form value must pass, got %v", err)
+	}
+	if r, _ := (Validator{"title": Required()}).ValidateSync(multipart.Form{}); r {
+		t.Errorf("A missing parameter of a multipart.Form value must fail")
+	}
+}
@@ -0,0 +1,128 @@
+package checkit
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Option configures a validation call
+type Option func(*options)
+
+type options struct {
+	concurrency int
+	labels      map[string]string
+	localized   bool
+	locales     []language.Tag
+	localizer   *Localizer
+}
+
+type optionsKey struct{}
+
+// WithConcurrency bounds the number of key paths evaluated at the same time by Validate,
+// it defaults to GOMAXPROCS
+func WithConcurrency(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// defaultMessageSubjects start the default messages of the rules, they are replaced by the label of the key path
+var defaultMessageSubjects = []string{"The value", "The given value", "The field"}
+
+// WithLabel names the value at a key path in default messages, WithMessage templates and catalog messages.
+// The key path may be declared with all, any, first and last, e.g. "items.all.price".
+func WithLabel(keyPath string, label string) Option {
+	return func(o *options) {
+		if o.labels == nil {
+			o.labels = make(map[string]string)
+		}
+		o.labels[keyPath] = label
+	}
+}
+
+func newOptions(opts []Option) *options {
+	var o = &options{
+		concurrency: runtime.GOMAXPROCS(0),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func withOptions(ctx context.Context, o *options) context.Context {
+	return context.WithValue(ctx, optionsKey{}, o)
+}
+
+func optionsOf(ctx context.Context) *options {
+	if o, ok := ctx.Value(optionsKey{}).(*options); ok {
+		return o
+	}
+	return newOptions(nil)
+}
+
+// labelFor returns the label of a resolved key path, the key path itself when it has none
+func (o *options) labelFor(keyPath string) string {
+	if label, ok := o.declaredLabelFor(keyPath); ok {
+		return label
+	}
+	return keyPath
+}
+
+func (o *options) declaredLabelFor(keyPath string) (string, bool) {
+	if label, ok := o.labels[keyPath]; ok {
+		return label, true
+	}
+	var declared = make([]string, 0, len(o.labels))
+	for k := range o.labels {
+		declared = append(declared, k)
+	}
+	sort.Strings(declared)
+	for _, k := range declared {
+		if keyPathMatches(k, keyPath) {
+			return o.labels[k], true
+		}
+	}
+	return "", false
+}
+
+// labelMessage names the value of a default message with the label of its key path,
+// e.g. "The value must be a valid email." becomes "Email address must be a valid email."
+func (o *options) labelMessage(fieldErr *FieldError) *FieldError {
+	label, ok := o.declaredLabelFor(fieldErr.KeyPath)
+	if !ok {
+		return fieldErr
+	}
+	for _, subject := range defaultMessageSubjects {
+		if strings.HasPrefix(fieldErr.Message, subject+" ") {
+			labeled := *fieldErr
+			labeled.Message = label + strings.TrimPrefix(fieldErr.Message, subject)
+			return &labeled
+		}
+	}
+	return fieldErr
+}
+
+// keyPathMatches reports whether a resolved key path such as "items.3.price" matches a declared one such as "items.all.price"
+func keyPathMatches(declared string, keyPath string) bool {
+	declaredKeys, keys := splitKeyPath(declared), splitKeyPath(keyPath)
+	if len(declaredKeys) != len(keys) {
+		return false
+	}
+	for i, key := range declaredKeys {
+		switch key {
+		case keyAll, keyAny, keyFirst, keyLast:
+			continue
+		}
+		if key != keys[i] {
+			return false
+		}
+	}
+	return true
+}
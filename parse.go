@@ -0,0 +1,229 @@
+package checkit
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const argSeparator = ':'
+
+var regexDateLiteral = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(T\d{2}:\d{2}(:\d{2}(\.\d+)?)?(Z|[+-]\d{2}:\d{2})?)?`)
+
+// rawArgRules read their bare arguments as written, e.g. the pattern of matches:007 is "007" rather than 7
+var rawArgRules = map[string]bool{
+	"matches":    true,
+	"notMatches": true,
+}
+
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ParseError reports an invalid rule string, Column is the 1-based position of the offending byte
+type ParseError struct {
+	Input   string
+	Column  int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s at column %d of %q", e.Message, e.Column, e.Input)
+}
+
+// Parse builds a rule from a rule string such as "required|email|maxLength:255".
+// Rules are separated by "|", arguments follow the rule name and are separated by ":".
+// Arguments are read as integers, floats, booleans, dates (2006-01-02 or RFC 3339),
+// double quoted strings with Go escapes, or plain strings otherwise.
+// The patterns of matches and notMatches are read as written.
+func Parse(rules string) (Validating, error) {
+	return DefaultRegistry.Parse(rules)
+}
+
+// ParseValidator builds a Validator from rule strings keyed by key path
+func ParseValidator(rules map[string]string) (Validator, error) {
+	return DefaultRegistry.ParseValidator(rules)
+}
+
+// Parse builds a rule from a rule string with rules looked up in the registry
+func (r *Registry) Parse(rules string) (Validating, error) {
+	p := &ruleParser{registry: r, input: rules, separator: '|', argsStart: argSeparator}
+	return p.parse()
+}
+
+// ParseValidator builds a Validator from rule strings with rules looked up in the registry
+func (r *Registry) ParseValidator(rules map[string]string) (Validator, error) {
+	var keyPaths = make([]string, 0, len(rules))
+	for keyPath := range rules {
+		keyPaths = append(keyPaths, keyPath)
+	}
+	sort.Strings(keyPaths)
+	var validator = make(Validator, len(rules))
+	for _, keyPath := range keyPaths {
+		validating, err := r.Parse(rules[keyPath])
+		if err != nil {
+			return nil, fmt.Errorf("key path %q: %w", keyPath, err)
+		}
+		validator[keyPath] = validating
+	}
+	return validator, nil
+}
+
+type ruleParser struct {
+	registry  *Registry
+	input     string
+	pos       int
+	separator byte // between two rules
+	argsStart byte // between a rule name and its arguments
+}
+
+func (p *ruleParser) parse() (Validating, error) {
+	var validatings CompoundValidating
+	for {
+		p.skipSpaces()
+		validating, err := p.parseRule()
+		if err != nil {
+			return nil, err
+		}
+		validatings = append(validatings, validating)
+		p.skipSpaces()
+		if p.pos == len(p.input) {
+			break
+		}
+		if p.input[p.pos] != p.separator {
+			return nil, p.errorAt(p.pos, fmt.Sprintf("expected %q", p.separator))
+		}
+		p.pos++
+	}
+	return applyPresenceMarkers(validatings), nil
+}
+
+func (p *ruleParser) parseRule() (Validating, error) {
+	start := p.pos
+	for p.pos < len(p.input) && isNameByte(p.input[p.pos], p.pos == start) {
+		p.pos++
+	}
+	if p.pos == start {
+		return nil, p.errorAt(start, "expected a rule name")
+	}
+	name := p.input[start:p.pos]
+	factory, ok := p.registry.Lookup(name)
+	if !ok {
+		return nil, p.errorAt(start, fmt.Sprintf("unknown rule %q", name))
+	}
+	var args []interface{}
+	if p.pos < len(p.input) && p.input[p.pos] == p.argsStart {
+		p.pos++
+		for {
+			arg, err := p.parseArg(rawArgRules[name])
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.pos == len(p.input) || p.input[p.pos] != argSeparator {
+				break
+			}
+			p.pos++
+		}
+	}
+	validating, err := factory(args...)
+	if err != nil {
+		return nil, p.errorAt(start, fmt.Sprintf("rule %q: %v", name, err))
+	}
+	return validating, nil
+}
+
+// parseArg reads a quoted string or a bare argument, which is typed unless raw is set
+func (p *ruleParser) parseArg(raw bool) (interface{}, error) {
+	start := p.pos
+	if p.pos < len(p.input) && p.input[p.pos] == '"' {
+		end := p.pos + 1
+		for end < len(p.input) && p.input[end] != '"' {
+			if p.input[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(p.input) {
+			return nil, p.errorAt(start, "unterminated string")
+		}
+		s, err := strconv.Unquote(p.input[start : end+1])
+		if err != nil {
+			return nil, p.errorAt(start, "invalid string")
+		}
+		p.pos = end + 1
+		return s, nil
+	}
+	if date := regexDateLiteral.FindString(p.input[start:]); !raw && len(date) > 0 && p.isArgEnd(start+len(date)) {
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, date); err == nil {
+				p.pos = start + len(date)
+				return t, nil
+			}
+		}
+		return nil, p.errorAt(start, "invalid date")
+	}
+	for p.pos < len(p.input) && !p.isArgEnd(p.pos) {
+		p.pos++
+	}
+	text := strings.TrimSpace(p.input[start:p.pos])
+	if len(text) == 0 {
+		return nil, p.errorAt(start, "expected an argument")
+	}
+	if raw {
+		return text, nil
+	}
+	return parseBareArg(text), nil
+}
+
+func (p *ruleParser) isArgEnd(pos int) bool {
+	return pos == len(p.input) || p.input[pos] == argSeparator || p.input[pos] == p.separator
+}
+
+func (p *ruleParser) skipSpaces() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *ruleParser) errorAt(pos int, message string) error {
+	return &ParseError{
+		Input:   p.input,
+		Column:  pos + 1,
+		Message: message,
+	}
+}
+
+func isNameByte(c byte, first bool) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	default:
+		return false
+	}
+}
+
+// parseBareArg reads booleans, integers and floats, anything else is kept as a string
+func parseBareArg(raw string) interface{} {
+	switch raw {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if intValue, err := strconv.Atoi(raw); err == nil {
+		return intValue
+	}
+	if floatValue, err := strconv.ParseFloat(raw, 64); err == nil {
+		return floatValue
+	}
+	return raw
+}
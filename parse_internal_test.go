@@ -0,0 +1,134 @@
+package checkit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse_shouldCombineRules(t *testing.T) {
+	validating, err := Parse("string|minLength:2|maxLength:3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate("abc"); !r {
+		t.Errorf("%q must be valid", "abc")
+	}
+	if r, _ := validating.Validate("abcd"); r {
+		t.Errorf("%q must be invalid", "abcd")
+	}
+}
+
+func TestParse_shouldParseTypedArguments(t *testing.T) {
+	validating, err := Parse(`between:2020-01-01:2020-12-31T23:59:59Z`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)); !r {
+		t.Errorf("Date must be between the bounds")
+	}
+	validating, err = Parse(`between:-1.5:2`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate(-1.0); !r {
+		t.Errorf("Number must be between the bounds")
+	}
+	validating, err = Parse(`contains:"a\"|b"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate([]string{`a"|b`}); !r {
+		t.Errorf("Quoted string must be unescaped")
+	}
+}
+
+func TestParse_whenInvalid_shouldReportColumn(t *testing.T) {
+	cases := map[string]int{
+		"":                 1,
+		"string|":          8,
+		"string|unknown":   8,
+		"maxLength:a":      1,
+		`contains:"abc`:    10,
+		"string maxLength": 8,
+	}
+	for input, column := range cases {
+		_, err := Parse(input)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Parsing %q must fail with a *ParseError, got %v", input, err)
+			continue
+		}
+		if parseErr.Column != column {
+			t.Errorf("Parsing %q must fail at column %d, got %d", input, column, parseErr.Column)
+		}
+	}
+}
+
+func TestParseValidator(t *testing.T) {
+	validator, err := ParseValidator(map[string]string{
+		"a": "between:0:2",
+		"b": "maxLength:2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validator.ValidateSync(map[string]interface{}{"a": 1, "b": "ab"}); !r {
+		t.Fail()
+	}
+}
+
+func TestRegister_shouldMakeRuleAddressable(t *testing.T) {
+	Register("testOdd", func(args ...interface{}) (Validating, error) {
+		return &validator{
+			validateFunc: func(value interface{}) (bool, error) {
+				v, ok := value.(int)
+				return ok && v%2 == 1, nil
+			},
+			errorMessage: "The value must be odd.",
+			rule:         "test_odd",
+		}, nil
+	})
+	validating, err := Parse("testOdd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate(3); !r {
+		t.Fail()
+	}
+}
+
+func TestParse_matches(t *testing.T) {
+	validating, err := Parse(`matches:^[A-Z]{3}$|notMatches:"^(USD|EUR)$"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate("VND"); !r {
+		t.Errorf("%q must be valid", "VND")
+	}
+	if r, _ := validating.Validate("USD"); r {
+		t.Errorf("%q must be invalid", "USD")
+	}
+	if _, err := Parse(`matches:"["`); err == nil {
+		t.Errorf("Invalid pattern must fail to parse")
+	}
+}
+
+func TestParse_matchesShouldReadPatternsAsWritten(t *testing.T) {
+	for pattern, value := range map[string]string{"007": "x007", "1e3": "1e3", "2020-01-01": "2020-01-01", "true": "true"} {
+		validating, err := Parse("matches:" + pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r, err := validating.Validate(value); !r {
+			t.Errorf("%q must match %q, got %v", value, pattern, err)
+		}
+	}
+	validating, err := DefaultRegistry.parseTag("matches=007")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate("7"); r {
+		t.Errorf("%q must not match %q", "7", "007")
+	}
+}
@@ -0,0 +1,116 @@
+package checkit
+
+import (
+	"context"
+	"reflect"
+)
+
+// Required requires the value to be present and not nil, zero values such as "" or 0 pass, see NotZero
+func Required() Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			return !isNil(value), nil
+		},
+		errorMessage: "The value is required.",
+		rule:         CodeRequired,
+		acceptsNil:   true,
+	}
+}
+
+// NotZero requires the value to be present and not the zero value of its type
+func NotZero() Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			if isNil(value) {
+				return false, nil
+			}
+			return !reflect.ValueOf(value).IsZero(), nil
+		},
+		errorMessage: "The value must not be a zero value.",
+		rule:         CodeNotZero,
+		acceptsNil:   true,
+	}
+}
+
+// Optional skips the rule when the key path of a Validator does not lead to a value,
+// an explicit nil such as a JSON null is still validated, see Nullable
+//
+//	"nickname": Optional(MinLength(3))
+func Optional(validating Validating) Validating {
+	return &presenceValidator{
+		validating:  validating,
+		allowAbsent: true,
+	}
+}
+
+// Nullable skips the rule when the value is an explicit nil, a missing value is still validated.
+// Combine it with Optional to accept both, e.g. Optional(Nullable(Email())).
+func Nullable(validating Validating) Validating {
+	return &presenceValidator{
+		validating: validating,
+		allowNil:   true,
+	}
+}
+
+type presenceValidator struct {
+	validating  Validating
+	allowAbsent bool
+	allowNil    bool
+}
+
+func (v *presenceValidator) Validate(value interface{}) (bool, error) {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v *presenceValidator) ValidateContext(ctx context.Context, value interface{}) (bool, error) {
+	if v.allowAbsent && isAbsent(ctx) || v.allowNil && isExplicitNil(ctx, value) || v.validating == nil {
+		return true, nil
+	}
+	if errs := collectFieldErrors(ctx, v.validating, value, "", false); len(errs) > 0 {
+		return false, ValidationErrors(errs)
+	}
+	return true, nil
+}
+
+// optionalMarker and nullableMarker let rule strings write "optional|email",
+// they are turned into Optional and Nullable by applyPresenceMarkers
+func optionalMarker() Validating {
+	return Optional(nil)
+}
+
+func nullableMarker() Validating {
+	return Nullable(nil)
+}
+
+// applyPresenceMarkers wraps the other rules of a parsed rule string with its presence markers
+func applyPresenceMarkers(validatings CompoundValidating) Validating {
+	var markers []*presenceValidator
+	var rules CompoundValidating
+	for _, validating := range validatings {
+		if marker, ok := validating.(*presenceValidator); ok && marker.validating == nil {
+			markers = append(markers, marker)
+			continue
+		}
+		rules = append(rules, validating)
+	}
+	var validating Validating
+	switch len(rules) {
+	case 0:
+		break
+	case 1:
+		validating = rules[0]
+	default:
+		validating = rules
+	}
+	if len(markers) == 0 {
+		return validating
+	}
+	for _, marker := range markers {
+		validating = &presenceValidator{
+			validating:  validating,
+			allowAbsent: marker.allowAbsent,
+			allowNil:    marker.allowNil,
+		}
+	}
+	return validating
+}
@@ -0,0 +1,78 @@
+package checkit
+
+import (
+	"testing"
+)
+
+func TestPresence_whenKeyMissingOrNil(t *testing.T) {
+	validator := Validator{
+		"name":     Optional(MinLength(3)),
+		"nickname": Nullable(MinLength(3)),
+		"email":    Optional(Nullable(Email())),
+	}
+	if r, err := validator.ValidateAll(map[string]interface{}{"nickname": nil, "email": nil}); !r {
+		t.Errorf("A missing optional value and a nil nullable value must pass, got %v", err)
+	}
+	_, err := validator.ValidateAll(map[string]interface{}{"name": nil})
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 || errs[0].KeyPath != "name" || errs[1].KeyPath != "nickname" {
+		t.Errorf("A nil optional value and a missing nullable value must be validated, got %v", err)
+	}
+}
+
+func TestPresence_whenParentMissing_shouldBeAbsent(t *testing.T) {
+	validator := Validator{
+		"profile.Name": Optional(String()),
+	}
+	if r, err := validator.ValidateSync(map[string]interface{}{"profile": nil}); !r {
+		t.Errorf("A value under a nil parent must be absent, got %v", err)
+	}
+	type profile struct {
+		Name *string
+	}
+	if r, _ := validator.ValidateSync(map[string]interface{}{"profile": profile{}}); r {
+		t.Errorf("A nil struct field must be present")
+	}
+}
+
+func TestRequired(t *testing.T) {
+	var nilPointer *int
+	if r, _ := Required().Validate(nilPointer); r {
+		t.Errorf("A nil pointer must not pass")
+	}
+	if r, _ := Required().Validate(""); !r {
+		t.Errorf("A zero value must pass")
+	}
+	if r, _ := NotZero().Validate(""); r {
+		t.Errorf("A zero value must not pass")
+	}
+	if r, _ := NotZero().Validate([]int{}); !r {
+		t.Errorf("An empty slice is not a zero value")
+	}
+}
+
+func TestParse_presenceMarkers(t *testing.T) {
+	validating, err := Parse("optional|nullable|string|minLength:3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := Validator{"name": validating}
+	if r, _ := validator.ValidateSync(map[string]interface{}{}); !r {
+		t.Errorf("A missing value must pass")
+	}
+	if r, _ := validator.ValidateSync(map[string]interface{}{"name": nil}); !r {
+		t.Errorf("A nil value must pass")
+	}
+	if r, _ := validator.ValidateSync(map[string]interface{}{"name": "ab"}); r {
+		t.Errorf("A short value must not pass")
+	}
+}
+
+func TestArrayAndBoolean_whenNil_shouldNotPanic(t *testing.T) {
+	if r, _ := Array().Validate(nil); r {
+		t.Fail()
+	}
+	if r, _ := Boolean().Validate(nil); r {
+		t.Fail()
+	}
+}
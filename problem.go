@@ -0,0 +1,232 @@
+package checkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Media types written by WriteProblem
+const (
+	ProblemContentType = "application/problem+json"
+	JSONAPIContentType = "application/vnd.api+json"
+)
+
+// Problem is an RFC 7807 problem details document listing validation failures in its errors extension
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors"`
+}
+
+// ProblemError is a validation failure, Pointer is the RFC 6901 JSON Pointer of the failing value
+type ProblemError struct {
+	Pointer string                 `json:"pointer"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+}
+
+// JSONAPIErrors is a JSON:API document made of error objects
+type JSONAPIErrors struct {
+	Errors []JSONAPIError `json:"errors"`
+}
+
+// JSONAPIError is a JSON:API error object, the params of the rule are reported in Meta
+type JSONAPIError struct {
+	Status string                 `json:"status"`
+	Code   string                 `json:"code"`
+	Title  string                 `json:"title"`
+	Detail string                 `json:"detail"`
+	Source JSONAPIErrorSource     `json:"source"`
+	Meta   map[string]interface{} `json:"meta,omitempty"`
+}
+
+// JSONAPIErrorSource points at the failing value
+type JSONAPIErrorSource struct {
+	Pointer string `json:"pointer"`
+}
+
+// ProblemOption configures NewProblem, NewJSONAPIErrors and WriteProblem
+type ProblemOption func(*problemOptions)
+
+type problemOptions struct {
+	typeURI       string
+	title         string
+	status        int
+	detail        string
+	instance      string
+	pointerPrefix string
+	jsonAPI       bool
+}
+
+// WithProblemType sets the type URI of the problem, it defaults to "about:blank"
+func WithProblemType(uri string) ProblemOption {
+	return func(o *problemOptions) {
+		o.typeURI = uri
+	}
+}
+
+// WithProblemTitle sets the title of the problem, it defaults to the status text
+func WithProblemTitle(title string) ProblemOption {
+	return func(o *problemOptions) {
+		o.title = title
+	}
+}
+
+// WithProblemStatus sets the HTTP status, it defaults to 422 Unprocessable Entity
+func WithProblemStatus(status int) ProblemOption {
+	return func(o *problemOptions) {
+		o.status = status
+	}
+}
+
+// WithProblemDetail sets the detail and the instance of the problem
+func WithProblemDetail(detail string, instance string) ProblemOption {
+	return func(o *problemOptions) {
+		o.detail = detail
+		o.instance = instance
+	}
+}
+
+// WithPointerPrefix prefixes the pointers, e.g. "/data/attributes" for JSON:API documents
+func WithPointerPrefix(prefix string) ProblemOption {
+	return func(o *problemOptions) {
+		o.pointerPrefix = prefix
+	}
+}
+
+// AsJSONAPI makes WriteProblem write a JSON:API document instead of a problem
+func AsJSONAPI() ProblemOption {
+	return func(o *problemOptions) {
+		o.jsonAPI = true
+	}
+}
+
+func newProblemOptions(opts []ProblemOption) *problemOptions {
+	var o = &problemOptions{
+		typeURI: "about:blank",
+		status:  http.StatusUnprocessableEntity,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if len(o.title) == 0 {
+		o.title = http.StatusText(o.status)
+	}
+	return o
+}
+
+// NewProblem converts the error of a validation, a *FieldError or a ValidationErrors,
+// it returns nil for the other errors, e.g. a context error of Validate or the *PanicError of a rule
+func NewProblem(err error, opts ...ProblemOption) *Problem {
+	errs, ok := validationErrorsOf(err)
+	if !ok {
+		return nil
+	}
+	o := newProblemOptions(opts)
+	var problem = &Problem{
+		Type:     o.typeURI,
+		Title:    o.title,
+		Status:   o.status,
+		Detail:   o.detail,
+		Instance: o.instance,
+		Errors:   make([]ProblemError, len(errs)),
+	}
+	for i, fieldErr := range errs {
+		problem.Errors[i] = ProblemError{
+			Pointer: o.pointerPrefix + fieldErr.Pointer(),
+			Code:    fieldErr.Rule,
+			Message: fieldErr.Message,
+			Params:  fieldErr.Params,
+		}
+	}
+	return problem
+}
+
+// NewJSONAPIErrors converts the error of a validation like NewProblem
+func NewJSONAPIErrors(err error, opts ...ProblemOption) *JSONAPIErrors {
+	errs, ok := validationErrorsOf(err)
+	if !ok {
+		return nil
+	}
+	o := newProblemOptions(opts)
+	var doc = &JSONAPIErrors{
+		Errors: make([]JSONAPIError, len(errs)),
+	}
+	for i, fieldErr := range errs {
+		doc.Errors[i] = JSONAPIError{
+			Status: strconv.Itoa(o.status),
+			Code:   fieldErr.Rule,
+			Title:  o.title,
+			Detail: fieldErr.Message,
+			Source: JSONAPIErrorSource{Pointer: o.pointerPrefix + fieldErr.Pointer()},
+			Meta:   fieldErr.Params,
+		}
+	}
+	return doc
+}
+
+// WriteProblem writes the error of a validation as a problem, or as a JSON:API document with AsJSONAPI.
+// It returns the error unchanged without writing anything when the error is not a validation error,
+// and the encoding error without writing anything when the document cannot be encoded, e.g. for an infinite param.
+func WriteProblem(w http.ResponseWriter, err error, opts ...ProblemOption) error {
+	o := newProblemOptions(opts)
+	var doc interface{}
+	var contentType string
+	if o.jsonAPI {
+		jsonAPIErrors := NewJSONAPIErrors(err, opts...)
+		if jsonAPIErrors == nil {
+			return err
+		}
+		doc, contentType = jsonAPIErrors, JSONAPIContentType
+	} else {
+		problem := NewProblem(err, opts...)
+		if problem == nil {
+			return err
+		}
+		doc, contentType = problem, ProblemContentType
+	}
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(doc); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(o.status)
+	_, err = body.WriteTo(w)
+	return err
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// KeyPathToPointer converts a resolved key path such as "items.3.price" into a JSON Pointer such as "/items/3/price"
+func KeyPathToPointer(keyPath string) string {
+	var sb strings.Builder
+	for _, key := range splitKeyPath(keyPath) {
+		sb.WriteByte('/')
+		sb.WriteString(pointerEscaper.Replace(key))
+	}
+	return sb.String()
+}
+
+// validationErrorsOf returns the failures of a validation, ok is false when one of them is an internal error,
+// e.g. a rule which panicked or which was given a value of a type it does not handle
+func validationErrorsOf(err error) (errs ValidationErrors, ok bool) {
+	var fieldErr *FieldError
+	if !errors.As(err, &errs) {
+		if !errors.As(err, &fieldErr) {
+			return nil, false
+		}
+		errs = ValidationErrors{fieldErr}
+	}
+	if len(errs) == 0 || firstInternalError(errs) != nil {
+		return nil, false
+	}
+	return errs, true
+}
@@ -0,0 +1,91 @@
+package checkit
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestWriteProblem(t *testing.T) {
+	_, err := Validator{
+		"items.all.price": GreaterThan(0),
+		"email":           Email(),
+	}.ValidateAll(map[string]interface{}{
+		"items": []map[string]int{{"price": 1}, {"price": 0}},
+		"email": "x",
+	})
+	recorder := httptest.NewRecorder()
+	if err := WriteProblem(recorder, err, WithProblemType("https://example.com/validation")); err != nil {
+		t.Fatal(err)
+	}
+	if recorder.Code != 422 || recorder.Header().Get("Content-Type") != ProblemContentType {
+		t.Errorf("Unexpected response %d %s", recorder.Code, recorder.Header().Get("Content-Type"))
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"type":   "https://example.com/validation",
+		"title":  "Unprocessable Entity",
+		"status": float64(422),
+		"errors": []interface{}{
+			map[string]interface{}{"pointer": "/email", "code": "email", "message": "The field must be a valid formatted e-mail address."},
+			map[string]interface{}{"pointer": "/items/1/price", "code": "greater_than", "message": "The value under validation must be \"greater than\" the given value.", "params": map[string]interface{}{"value": float64(0)}},
+		},
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected %v, got %v", expected, body)
+	}
+}
+
+func TestWriteProblem_asJSONAPI(t *testing.T) {
+	_, err := Validator{"name": MinLength(3)}.ValidateSync(map[string]string{"name": "a"})
+	recorder := httptest.NewRecorder()
+	if err := WriteProblem(recorder, err, AsJSONAPI(), WithPointerPrefix("/data/attributes")); err != nil {
+		t.Fatal(err)
+	}
+	var doc JSONAPIErrors
+	if err := json.Unmarshal(recorder.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if recorder.Header().Get("Content-Type") != JSONAPIContentType || len(doc.Errors) != 1 ||
+		doc.Errors[0].Status != "422" || doc.Errors[0].Code != CodeMinLength || doc.Errors[0].Source.Pointer != "/data/attributes/name" {
+		t.Errorf("Unexpected document %+v", doc)
+	}
+}
+
+func TestWriteProblem_whenNotValidationError_shouldNotWrite(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	if err := WriteProblem(recorder, context.Canceled); err != context.Canceled || recorder.Body.Len() != 0 {
+		t.Errorf("Nothing must be written, got %v", err)
+	}
+}
+
+func TestWriteProblem_whenRulePanics_shouldNotWrite(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	_, validationErr := Validator{"a": panickingRule{}, "b": Email()}.ValidateAll(map[string]interface{}{"a": 1, "b": "x"})
+	if err := WriteProblem(recorder, validationErr); err == nil || recorder.Body.Len() != 0 {
+		t.Errorf("Nothing must be written, got %v %s", err, recorder.Body)
+	}
+}
+
+func TestWriteProblem_whenParamsCannotBeEncoded_shouldNotWrite(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	_, validationErr := Validator{"a": Between(math.Inf(-1), 0.5)}.ValidateAll(map[string]interface{}{"a": 1})
+	if err := WriteProblem(recorder, validationErr); err == nil || recorder.Body.Len() != 0 || recorder.Header().Get("Content-Type") != "" {
+		t.Errorf("Nothing must be written, got %v %s", err, recorder.Body)
+	}
+}
+
+func TestKeyPathToPointer(t *testing.T) {
+	if pointer := KeyPathToPointer("a/b.c~d.0"); pointer != "/a~1b/c~0d/0" {
+		t.Errorf("Unexpected pointer %s", pointer)
+	}
+	if pointer := KeyPathToPointer(""); pointer != "" {
+		t.Errorf("The root must be the empty pointer, got %s", pointer)
+	}
+}
@@ -0,0 +1,91 @@
+package checkit
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// Registry maps rule names to factories, it resolves the names used in rule strings and struct tags.
+// A Registry is safe for concurrent use.
+type Registry struct {
+	mutex sync.RWMutex
+	rules map[string]RuleFactory
+
+	// structPlans caches a *structPlan per reflect.Type
+	structPlans sync.Map
+}
+
+// DefaultRegistry is used by Register, Parse, ParseValidator and ValidateStruct
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry returns a registry holding the built-in rules,
+// rules registered later are only visible through this registry
+func NewRegistry() *Registry {
+	var rules = make(map[string]RuleFactory, len(builtinRules))
+	for name, factory := range builtinRules {
+		rules[name] = factory
+	}
+	return &Registry{
+		rules: rules,
+	}
+}
+
+// Register makes a rule addressable by name from rule strings and struct tags of the default registry
+func Register(name string, factory RuleFactory) {
+	DefaultRegistry.Register(name, factory)
+}
+
+// Register makes a rule addressable by name, a rule registered under the name of a built-in replaces it
+func (r *Registry) Register(name string, factory RuleFactory) {
+	if len(name) == 0 || factory == nil {
+		panic("checkit: Register requires a name and a factory")
+	}
+	r.mutex.Lock()
+	r.rules[name] = factory
+	r.mutex.Unlock()
+
+	// Struct tags parsed before may refer to the previous rule
+	r.structPlans.Range(func(key, _ interface{}) bool {
+		r.structPlans.Delete(key)
+		return true
+	})
+}
+
+// RegisterPattern registers a rule named after the pattern in the default registry
+func RegisterPattern(name string, pattern string) error {
+	return DefaultRegistry.RegisterPattern(name, pattern)
+}
+
+// RegisterPattern registers a rule without arguments which passes when the value matches the pattern,
+// e.g. RegisterPattern("ticker", "^[A-Z]{3,5}$") makes "ticker" usable in rule strings and struct tags
+func (r *Registry) RegisterPattern(name string, pattern string) error {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	r.Register(name, noArgFactory(func() Validating {
+		return MatchesRegexp(regex)
+	}))
+	return nil
+}
+
+// Lookup returns the factory registered under the name
+func (r *Registry) Lookup(name string) (RuleFactory, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	factory, ok := r.rules[name]
+	return factory, ok
+}
+
+// Names returns the registered rule names in sorted order
+func (r *Registry) Names() []string {
+	r.mutex.RLock()
+	var names = make([]string, 0, len(r.rules))
+	for name := range r.rules {
+		names = append(names, name)
+	}
+	r.mutex.RUnlock()
+	sort.Strings(names)
+	return names
+}
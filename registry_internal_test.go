@@ -0,0 +1,80 @@
+package checkit
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestNewRegistry_shouldHoldBuiltins(t *testing.T) {
+	registry := NewRegistry()
+	for name := range builtinRules {
+		if _, ok := registry.Lookup(name); !ok {
+			t.Errorf("Rule %q must be registered", name)
+		}
+	}
+}
+
+func TestRegistryRegister_shouldBeIsolated(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("isChainID", noArgFactory(Natural))
+	if _, err := registry.Parse("isChainID"); err != nil {
+		t.Errorf("Rule must be addressable from its registry, got %v", err)
+	}
+	if _, err := NewRegistry().Parse("isChainID"); err == nil {
+		t.Errorf("Rule must not leak to other registries")
+	}
+	if _, err := Parse("isChainID"); err == nil {
+		t.Errorf("Rule must not leak to the default registry")
+	}
+}
+
+func TestRegistryRegister_shouldInvalidateStructPlans(t *testing.T) {
+	type tagged struct {
+		A int `checkit:"isPositive"`
+	}
+	registry := NewRegistry()
+	if _, err := registry.ValidateStruct(tagged{A: 1}); err == nil {
+		t.Errorf("Unknown rule must fail")
+	}
+	registry.Register("isPositive", func(args ...interface{}) (Validating, error) {
+		return GreaterThan(0), nil
+	})
+	if r, err := registry.ValidateStruct(tagged{A: 1}); !r {
+		t.Errorf("Struct must be valid, got %v", err)
+	}
+}
+
+func TestRegistry_shouldBeSafeForConcurrentUse(t *testing.T) {
+	registry := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("rule%d", i)
+			registry.Register(name, noArgFactory(String))
+			if _, err := registry.Parse(name + "|email"); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestRegistryRegisterPattern(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.RegisterPattern("ticker", "^[A-Z]{3,5}$"); err != nil {
+		t.Fatal(err)
+	}
+	validating, err := registry.Parse("ticker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := validating.Validate("BTC"); !r {
+		t.Fail()
+	}
+	if err := registry.RegisterPattern("invalid", "["); err == nil {
+		t.Errorf("Invalid pattern must not be registered")
+	}
+}
@@ -1,8 +1,12 @@
 package checkit
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math"
+	"net"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strings"
@@ -19,16 +23,7 @@ type CompoundValidating []Validating
 
 // Validate ...
 func (c CompoundValidating) Validate(value interface{}) (bool, error) {
-	for _, v := range c {
-		_r, err := v.Validate(value)
-		if err != nil {
-			return false, err
-		}
-		if !_r {
-			return false, nil
-		}
-	}
-	return true, nil
+	return c.ValidateContext(context.Background(), value)
 }
 
 // Accepted ...
@@ -64,6 +59,7 @@ func Accepted() Validating {
 			}
 		},
 		errorMessage: "The value must be yes, on, or 1. This is useful for validating \"Terms of Service\" acceptance.",
+		rule:         CodeAccepted,
 	}
 }
 
@@ -74,6 +70,7 @@ func Alpha() Validating {
 			return matchAnyWithRegex(regexAlpha, value)
 		},
 		errorMessage: "The value must be entirely alphabetic characters.",
+		rule:         CodeAlpha,
 	}
 }
 
@@ -84,6 +81,7 @@ func AlphaDash() Validating {
 			return matchAnyWithRegex(regexAlphaDash, value)
 		},
 		errorMessage: "The value may have alpha-numeric characters, as well as dashes and underscores.",
+		rule:         CodeAlphaDash,
 	}
 }
 
@@ -94,6 +92,7 @@ func AlphaNumeric() Validating {
 			return matchAnyWithRegex(regexAlphaNumeric, value)
 		},
 		errorMessage: "The value must be entirely alpha-numeric characters.",
+		rule:         CodeAlphaNumeric,
 	}
 }
 
@@ -104,6 +103,7 @@ func AlphaUnderscore() Validating {
 			return matchAnyWithRegex(regexAlphaUnderscore, value)
 		},
 		errorMessage: "The value must be entirely alpha-numeric, with underscores but not dashes.",
+		rule:         CodeAlphaUnderscore,
 	}
 }
 
@@ -111,7 +111,7 @@ func AlphaUnderscore() Validating {
 func Array() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			switch reflect.TypeOf(value).Kind() {
+			switch reflect.ValueOf(value).Kind() {
 			case reflect.Array, reflect.Slice:
 				return true, nil
 			default:
@@ -119,6 +119,8 @@ func Array() Validating {
 			}
 		},
 		errorMessage: "The value must be a valid array object.",
+		rule:         CodeArray,
+		allValues:    true,
 	}
 }
 
@@ -129,6 +131,7 @@ func Base64() Validating {
 			return matchAnyWithRegex(regexBase64, value)
 		},
 		errorMessage: "The value must be a base64 encoded value.",
+		rule:         CodeBase64,
 	}
 }
 
@@ -147,6 +150,8 @@ func Between(min interface{}, max interface{}) Validating {
 			return lCompare && rCompare, nil
 		},
 		errorMessage: "The value must have a size between the given min and max.",
+		rule:         CodeBetween,
+		params:       map[string]interface{}{"min": min, "max": max},
 	}
 }
 
@@ -154,7 +159,7 @@ func Between(min interface{}, max interface{}) Validating {
 func Boolean() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			switch reflect.TypeOf(value).Kind() {
+			switch reflect.ValueOf(value).Kind() {
 			case reflect.Bool:
 				return true, nil
 			default:
@@ -162,6 +167,7 @@ func Boolean() Validating {
 			}
 		},
 		errorMessage: "The value must be a boolean.",
+		rule:         CodeBoolean,
 	}
 }
 
@@ -182,6 +188,9 @@ func Contains(v interface{}) Validating {
 			return false, nil
 		},
 		errorMessage: "The value must contain the value.",
+		rule:         CodeContains,
+		params:       map[string]interface{}{"value": v},
+		allValues:    true,
 	}
 }
 
@@ -197,6 +206,7 @@ func Date() Validating {
 			}
 		},
 		errorMessage: "The value must be a valid date object.",
+		rule:         CodeDate,
 	}
 }
 
@@ -207,6 +217,7 @@ func Email() Validating {
 			return matchAnyWithRegex(regexEmail, value)
 		},
 		errorMessage: "The field must be a valid formatted e-mail address.",
+		rule:         CodeEmail,
 	}
 }
 
@@ -223,6 +234,20 @@ func Empty() Validating {
 			}
 		},
 		errorMessage: "The value must be a empty collection.",
+		rule:         CodeEmpty,
+	}
+}
+
+// Equals compares numbers, strings and dates by value, anything else with reflect.DeepEqual
+func Equals(v interface{}) Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			return isEqual(value, v), nil
+		},
+		errorMessage: "The value must be equal to the given value.",
+		rule:         CodeEquals,
+		acceptsNil:   true,
+		params:       map[string]interface{}{"value": v},
 	}
 }
 
@@ -239,6 +264,8 @@ func ExactLength(length int) Validating {
 			}
 		},
 		errorMessage: "The field must have the exact length of \"val\".",
+		rule:         CodeExactLength,
+		params:       map[string]interface{}{"length": length},
 	}
 }
 
@@ -249,6 +276,8 @@ func ExistsNonNil() Validating {
 			return value != nil, nil
 		},
 		errorMessage: "The value under validation must not be undefined or nil.",
+		rule:         CodeExistsNonNil,
+		acceptsNil:   true,
 	}
 }
 
@@ -270,6 +299,7 @@ func Finite() Validating {
 			}
 		},
 		errorMessage: "The value under validation must be a finite number.",
+		rule:         CodeFinite,
 	}
 }
 
@@ -277,7 +307,7 @@ func Finite() Validating {
 func Function() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			switch reflect.TypeOf(value).Kind() {
+			switch reflect.ValueOf(value).Kind() {
 			case reflect.Func:
 				return true, nil
 			default:
@@ -285,6 +315,7 @@ func Function() Validating {
 			}
 		},
 		errorMessage: "The value must be a function.",
+		rule:         CodeFunction,
 	}
 }
 
@@ -299,6 +330,8 @@ func GreaterThan(v interface{}) Validating {
 			return !lessThanEqualTo, nil
 		},
 		errorMessage: "The value under validation must be \"greater than\" the given value.",
+		rule:         CodeGreaterThan,
+		params:       map[string]interface{}{"value": v},
 	}
 }
 
@@ -309,6 +342,8 @@ func GreaterThanEqualTo(v interface{}) Validating {
 			return greatThanEqualTo(value, v)
 		},
 		errorMessage: "The value under validation must be \"greater than\" or \"equal to\" the given value.",
+		rule:         CodeGreaterThanEqualTo,
+		params:       map[string]interface{}{"value": v},
 	}
 }
 
@@ -318,7 +353,7 @@ func Integer() Validating {
 		validateFunc: func(value interface{}) (bool, error) {
 			switch v := value.(type) {
 			case string:
-				return regexp.MustCompile(regexInteger).MatchString(v), nil
+				return regexInteger.MatchString(v), nil
 			case int, int8, int16, int32, int64,
 				uint, uint8, uint16, uint32, uint64:
 				return true, nil
@@ -326,6 +361,7 @@ func Integer() Validating {
 			return false, nil
 		},
 		errorMessage: "The value must have an integer value.",
+		rule:         CodeInteger,
 	}
 }
 
@@ -336,6 +372,7 @@ func Ipv4() Validating {
 			return matchAnyWithRegex(regexIpv4, value)
 		},
 		errorMessage: "The value must be formatted as an IPv4 address.",
+		rule:         CodeIpv4,
 	}
 }
 
@@ -343,9 +380,10 @@ func Ipv4() Validating {
 func Ipv6() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			return matchAnyWithRegex(regexIpv6, value)
+			return isIpv6(value)
 		},
 		errorMessage: "The value must be formatted as an IPv6 address.",
+		rule:         CodeIpv6,
 	}
 }
 
@@ -360,6 +398,8 @@ func LessThan(v interface{}) Validating {
 			return !greatThanEqualTo, nil
 		},
 		errorMessage: "The value under validation must be \"less than\" the given value.",
+		rule:         CodeLessThan,
+		params:       map[string]interface{}{"value": v},
 	}
 }
 
@@ -370,6 +410,8 @@ func LessThanEqualTo(v interface{}) Validating {
 			return lessThanEqualTo(value, v)
 		},
 		errorMessage: "The value under validation must be \"less than\" or \"equal to\" the given value.",
+		rule:         CodeLessThanEqualTo,
+		params:       map[string]interface{}{"value": v},
 	}
 }
 
@@ -380,6 +422,49 @@ func Luhn() Validating {
 			return matchAnyWithRegex(regexLuhn, value)
 		},
 		errorMessage: "The given value must pass a basic luhn (credit card) check regular expression.",
+		rule:         CodeLuhn,
+	}
+}
+
+// Matches compiles the pattern, see MatchesRegexp
+func Matches(pattern string) (Validating, error) {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return MatchesRegexp(regex), nil
+}
+
+// MustMatches is like Matches but panics when the pattern does not compile
+func MustMatches(pattern string) Validating {
+	return MatchesRegexp(regexp.MustCompile(pattern))
+}
+
+// MatchesRegexp accepts strings, byte slices, fmt.Stringer and named string types matching the regular expression
+func MatchesRegexp(regex *regexp.Regexp) Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			return matchAnyWithRegex(regex, value)
+		},
+		errorMessage: "The value must match the pattern.",
+		rule:         CodeMatches,
+		params:       map[string]interface{}{"pattern": regex.String()},
+	}
+}
+
+// Max ...
+func Max(max interface{}) Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			size, err := sizeOf(value)
+			if err != nil {
+				return false, err
+			}
+			return lessThanEqualTo(size, max)
+		},
+		errorMessage: "The value must be less than a maximum value. Strings, numerics, and files are evaluated in the same fashion as the size rule.",
+		rule:         CodeMax,
+		params:       map[string]interface{}{"max": max},
 	}
 }
 
@@ -396,6 +481,24 @@ func MaxLength(length int) Validating {
 			}
 		},
 		errorMessage: "The value must have a length property which is less than or equal to the specified value. Note, this may be used with both arrays and strings.",
+		rule:         CodeMaxLength,
+		params:       map[string]interface{}{"length": length},
+	}
+}
+
+// Min ...
+func Min(min interface{}) Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			size, err := sizeOf(value)
+			if err != nil {
+				return false, err
+			}
+			return greatThanEqualTo(size, min)
+		},
+		errorMessage: "The value must have a minimum value. Strings, numerics, and files are evaluated in the same fashion as the size rule.",
+		rule:         CodeMin,
+		params:       map[string]interface{}{"min": min},
 	}
 }
 
@@ -412,6 +515,8 @@ func MinLength(length int) Validating {
 			}
 		},
 		errorMessage: "The value must have a length property which is greater than or equal to the specified value. Note, this may be used with both arrays and strings.",
+		rule:         CodeMinLength,
+		params:       map[string]interface{}{"length": length},
 	}
 }
 
@@ -421,7 +526,7 @@ func Natural() Validating {
 		validateFunc: func(value interface{}) (bool, error) {
 			switch v := value.(type) {
 			case string:
-				return regexp.MustCompile(regexNatural).MatchString(v), nil
+				return regexNatural.MatchString(v), nil
 			case int:
 				return v >= 0, nil
 			case int8:
@@ -439,6 +544,7 @@ func Natural() Validating {
 			}
 		},
 		errorMessage: "The value must be a natural number (a number greater than or equal to 0).",
+		rule:         CodeNatural,
 	}
 }
 
@@ -457,6 +563,7 @@ func NaN() Validating {
 			}
 		},
 		errorMessage: "The value under validation must be a NaN.",
+		rule:         CodeNaN,
 	}
 }
 
@@ -466,7 +573,7 @@ func NaturalNonZero() Validating {
 		validateFunc: func(value interface{}) (bool, error) {
 			switch v := value.(type) {
 			case string:
-				return regexp.MustCompile(regexNaturalNonZero).MatchString(v), nil
+				return regexNaturalNonZero.MatchString(v), nil
 			case int:
 				return v > 0, nil
 			case int8:
@@ -492,6 +599,37 @@ func NaturalNonZero() Validating {
 			}
 		},
 		errorMessage: "The value must be a natural number, greater than or equal to 1.",
+		rule:         CodeNaturalNonZero,
+	}
+}
+
+// NotMatches compiles the pattern, see NotMatchesRegexp
+func NotMatches(pattern string) (Validating, error) {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return NotMatchesRegexp(regex), nil
+}
+
+// MustNotMatches is like NotMatches but panics when the pattern does not compile
+func MustNotMatches(pattern string) Validating {
+	return NotMatchesRegexp(regexp.MustCompile(pattern))
+}
+
+// NotMatchesRegexp accepts strings, byte slices, fmt.Stringer and named string types not matching the regular expression
+func NotMatchesRegexp(regex *regexp.Regexp) Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			s, ok := stringOf(value)
+			if !ok {
+				return false, errors.New("The value must be a string")
+			}
+			return !regex.MatchString(s), nil
+		},
+		errorMessage: "The value must not match the pattern.",
+		rule:         CodeNotMatches,
+		params:       map[string]interface{}{"pattern": regex.String()},
 	}
 }
 
@@ -499,7 +637,7 @@ func NaturalNonZero() Validating {
 func Object() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			switch reflect.TypeOf(value).Kind() {
+			switch reflect.ValueOf(value).Kind() {
 			case reflect.Invalid, reflect.Func, reflect.UnsafePointer:
 				return false, nil
 			default:
@@ -507,6 +645,7 @@ func Object() Validating {
 			}
 		},
 		errorMessage: "The value must be a object.",
+		rule:         CodeObject,
 	}
 }
 
@@ -514,7 +653,7 @@ func Object() Validating {
 func PlainObject() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			switch reflect.TypeOf(value).Kind() {
+			switch reflect.ValueOf(value).Kind() {
 			case reflect.Map:
 				return true, nil
 			default:
@@ -522,6 +661,7 @@ func PlainObject() Validating {
 			}
 		},
 		errorMessage: "The value must be a plain object.",
+		rule:         CodePlainObject,
 	}
 }
 
@@ -537,6 +677,31 @@ func Regex() Validating {
 			}
 		},
 		errorMessage: "The value must be a RegExp object.",
+		rule:         CodeRegex,
+	}
+}
+
+// Size ...
+func Size(size interface{}) Validating {
+	return &validator{
+		validateFunc: func(value interface{}) (bool, error) {
+			valueSize, err := sizeOf(value)
+			if err != nil {
+				return false, err
+			}
+			lCompare, lErr := lessThanEqualTo(valueSize, size)
+			if lErr != nil {
+				return false, lErr
+			}
+			rCompare, rErr := greatThanEqualTo(valueSize, size)
+			if rErr != nil {
+				return false, rErr
+			}
+			return lCompare && rCompare, nil
+		},
+		errorMessage: "The value must have the given size. Numerics are compared by value, strings by their number of characters, collections by their number of elements and files by their number of bytes.",
+		rule:         CodeSize,
+		params:       map[string]interface{}{"size": size},
 	}
 }
 
@@ -544,14 +709,15 @@ func Regex() Validating {
 func String() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			switch reflect.TypeOf(value).Kind() {
+			switch reflect.ValueOf(value).Kind() {
 			case reflect.String:
 				return true, nil
 			default:
 				return false, nil
 			}
 		},
-		errorMessage: "The value must be a function.",
+		errorMessage: "The value must be a string.",
+		rule:         CodeString,
 	}
 }
 
@@ -559,9 +725,10 @@ func String() Validating {
 func URL() Validating {
 	return &validator{
 		validateFunc: func(value interface{}) (bool, error) {
-			return matchAnyWithRegex(regexURL, value)
+			return isURL(value)
 		},
 		errorMessage: "The value must be formatted as an URL.",
+		rule:         CodeURL,
 	}
 }
 
@@ -572,33 +739,69 @@ func UUID() Validating {
 			return matchAnyWithRegex(regexUUID, value)
 		},
 		errorMessage: "Passes for a validly formatted UUID.",
+		rule:         CodeUUID,
 	}
 }
 
-const (
-	regexAlpha           = `/^[A_Za-z]+$/i`
-	regexAlphaDash       = `/^[A_Za-z0-9_\-]+$/i`
-	regexAlphaNumeric    = `/^[A_Za-z0-9]+$/i`
-	regexAlphaUnderscore = `/^[A_Za-z0-9_]+$/i`
-	regexBase64          = `/^(?:[A-Za-z0-9+\/]{4})*(?:[A-Za-z0-9+\/]{2}==|[A-Za-z0-9+\/]{3}=)?$/`
-	regexEmail           = `/^(.+)@(.+)\.(.+)$/i`
-	regexInteger         = `/^\-?[0-9]+$/`
-	regexIpv4            = `/^((25[0-5]|2[0-4][0-9]|1[0-9]{2}|[0-9]{1,2})\.){3}(25[0-5]|2[0-4][0-9]|1[0-9]{2}|[0-9]{1,2})$/i`
-	regexIpv6            = `/^((?=.*::)(?!.*::.+::)(::)?([\dA-F]{1,4}:(:|\b)|){5}|([\dA-F]{1,4}:){6})((([\dA-F]{1,4}((?!\3)::|:\b|$))|(?!\2\3)){2}|(((2[0-4]|1\d|[1-9])?\d|25[0-5])\.?\b){4})$/i`
-	regexLuhn            = `/^(?:4[0-9]{12}(?:[0-9]{3})?|5[1-5][0-9]{14}|6(?:011|5[0-9][0-9])[0-9]{12}|3[47][0-9]{13}|3(?:0[0-5]|[68][0-9])[0-9]{11}|(?:2131|1800|35\d{3})\d{11})$/`
-	regexNatural         = `/^[0-9]+$/i`
-	regexNaturalNonZero  = `/^[1-9][0-9]*$/i`
-	regexURL             = `/^((http|https):\/\/(\w+:{0,1}\w*@)?(\S+)|)(:[0-9]+)?(\/|\/([\w#!:.?+=&%@!\-\/]))?$/`
-	regexUUID            = `/^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$/i`
+var (
+	regexAlpha           = regexp.MustCompile(`^[A-Za-z]+$`)
+	regexAlphaDash       = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+	regexAlphaNumeric    = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+	regexAlphaUnderscore = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	regexBase64          = regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`)
+	regexEmail           = regexp.MustCompile(`^(.+)@(.+)\.(.+)$`)
+	regexInteger         = regexp.MustCompile(`^-?[0-9]+$`)
+	regexIpv4            = regexp.MustCompile(`^((25[0-5]|2[0-4][0-9]|1[0-9]{2}|[0-9]{1,2})\.){3}(25[0-5]|2[0-4][0-9]|1[0-9]{2}|[0-9]{1,2})$`)
+	regexLuhn            = regexp.MustCompile(`^(?:4[0-9]{12}(?:[0-9]{3})?|5[1-5][0-9]{14}|6(?:011|5[0-9][0-9])[0-9]{12}|3[47][0-9]{13}|3(?:0[0-5]|[68][0-9])[0-9]{11}|(?:2131|1800|35\d{3})\d{11})$`)
+	regexNatural         = regexp.MustCompile(`^[0-9]+$`)
+	regexNaturalNonZero  = regexp.MustCompile(`^[1-9][0-9]*$`)
+	regexUUID            = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
 )
 
-func matchAnyWithRegex(regex string, any interface{}) (bool, error) {
+func matchAnyWithRegex(regex *regexp.Regexp, any interface{}) (bool, error) {
+	s, ok := stringOf(any)
+	if !ok {
+		return false, errors.New("The value must be a string")
+	}
+	return regex.MatchString(s), nil
+}
+
+// stringOf reads strings, byte slices, fmt.Stringer and named string types
+func stringOf(any interface{}) (string, bool) {
 	switch v := any.(type) {
 	case string:
-		return regexp.MustCompile(regex).MatchString(v), nil
-	default:
+		return v, true
+	case []byte:
+		return string(v), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	if val := reflect.ValueOf(any); val.Kind() == reflect.String {
+		return val.String(), true
+	}
+	return "", false
+}
+
+// isIpv6 relies on net.ParseIP since RE2 has no lookaheads to express IPv6 abbreviations
+func isIpv6(any interface{}) (bool, error) {
+	s, ok := stringOf(any)
+	if !ok {
+		return false, errors.New("The value must be a string")
+	}
+	return strings.Contains(s, ":") && net.ParseIP(s) != nil, nil
+}
+
+// isURL accepts absolute http and https URLs
+func isURL(any interface{}) (bool, error) {
+	s, ok := stringOf(any)
+	if !ok {
 		return false, errors.New("The value must be a string")
 	}
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false, nil
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && len(u.Host) > 0, nil
 }
 
 func greatThanEqualTo(lhs interface{}, rhs interface{}) (bool, error) {
@@ -701,8 +904,17 @@ func lessThanEqualTo(lhs interface{}, rhs interface{}) (bool, error) {
 	return lhsFloat64 <= rhsFloat64, nil
 }
 
+func isEqual(lhs interface{}, rhs interface{}) bool {
+	lessThanEqualTo, lErr := lessThanEqualTo(lhs, rhs)
+	greatThanEqualTo, gErr := greatThanEqualTo(lhs, rhs)
+	if lErr == nil && gErr == nil {
+		return lessThanEqualTo && greatThanEqualTo
+	}
+	return reflect.DeepEqual(lhs, rhs)
+}
+
 func isUnsignedNumber(any interface{}) bool {
-	switch reflect.TypeOf(any).Kind() {
+	switch reflect.ValueOf(any).Kind() {
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
 		reflect.Uint64:
 		return true
@@ -775,6 +987,25 @@ func tryConvertToFloat64(v interface{}) (float64, bool) {
 		return float64(_v), true
 	case int8:
 		return float64(_v), true
+	case uint64:
+		return float64(_v), true
+	case uint:
+		return float64(_v), true
+	case uint32:
+		return float64(_v), true
+	case uint16:
+		return float64(_v), true
+	case uint8:
+		return float64(_v), true
+	}
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(val.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(val.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return val.Float(), true
 	default:
 		return 0, false
 	}
@@ -785,17 +1016,44 @@ type validateFunc func(interface{}) (bool, error)
 type validator struct {
 	validateFunc validateFunc
 	errorMessage string
+	rule         string
+	params       map[string]interface{}
+	acceptsNil   bool // nil is passed to validateFunc instead of failing with ErrValueMissing
+	allValues    bool // the rule is given every value of a multi-value parameter, see AllValues
 }
 
 func (v *validator) Validate(value interface{}) (bool, error) {
+	if !v.acceptsNil && isMissing(value) {
+		return v.result(value, false, ErrValueMissing)
+	}
 	result, err := v.validateFunc(value)
+	return v.result(value, result, err)
+}
+
+// isMissing reports whether the value is nil or a nil pointer, nil slices and maps are empty values
+func isMissing(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+func (v *validator) result(value interface{}, result bool, err error) (bool, error) {
 	if result {
 		return true, nil
 	}
+	fieldErr := &FieldError{
+		Rule:    v.rule,
+		Params:  v.params,
+		Value:   value,
+		Message: v.errorMessage,
+	}
 	if err != nil {
-		return false, err
+		fieldErr.Message = err.Error()
+		fieldErr.Err = err
 	}
-	return false, errors.New(v.errorMessage)
+	return false, fieldErr
 }
 
 func contains(arr []string, check string) bool {
@@ -0,0 +1,62 @@
+package checkit
+
+import (
+	"testing"
+)
+
+var stringRules = map[string]struct {
+	validating Validating
+	value      string
+}{
+	"Alpha":           {Alpha(), "abcdef"},
+	"AlphaDash":       {AlphaDash(), "abc-def_1"},
+	"AlphaNumeric":    {AlphaNumeric(), "abc123"},
+	"AlphaUnderscore": {AlphaUnderscore(), "abc_123"},
+	"Base64":          {Base64(), "aGVsbG8gd29ybGQ="},
+	"Email":           {Email(), "first.last@example.com"},
+	"Integer":         {Integer(), "-12345"},
+	"Ipv4":            {Ipv4(), "192.168.1.1"},
+	"Ipv6":            {Ipv6(), "2001:db8::8a2e:370:7334"},
+	"Luhn":            {Luhn(), "4111111111111111"},
+	"Natural":         {Natural(), "12345"},
+	"NaturalNonZero":  {NaturalNonZero(), "12345"},
+	"URL":             {URL(), "https://example.com/a?b=c"},
+	"UUID":            {UUID(), "123e4567-e89b-12d3-a456-426614174000"},
+}
+
+func TestStringRules_shouldNotCompilePerCall(t *testing.T) {
+	for name, rule := range stringRules {
+		var value interface{} = rule.value
+		allocs := testing.AllocsPerRun(100, func() {
+			rule.validating.Validate(value)
+		})
+		// Compiling a pattern allocates far more, URL and Ipv6 parsers allocate their result
+		if allocs > 2 {
+			t.Errorf("%s must not compile its pattern per call, got %v allocations", name, allocs)
+		}
+	}
+}
+
+func benchmarkStringRule(b *testing.B, name string) {
+	rule := stringRules[name]
+	var value interface{} = rule.value
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		rule.validating.Validate(value)
+	}
+}
+
+func BenchmarkAlpha(b *testing.B)           { benchmarkStringRule(b, "Alpha") }
+func BenchmarkAlphaDash(b *testing.B)       { benchmarkStringRule(b, "AlphaDash") }
+func BenchmarkAlphaNumeric(b *testing.B)    { benchmarkStringRule(b, "AlphaNumeric") }
+func BenchmarkAlphaUnderscore(b *testing.B) { benchmarkStringRule(b, "AlphaUnderscore") }
+func BenchmarkBase64(b *testing.B)          { benchmarkStringRule(b, "Base64") }
+func BenchmarkEmail(b *testing.B)           { benchmarkStringRule(b, "Email") }
+func BenchmarkInteger(b *testing.B)         { benchmarkStringRule(b, "Integer") }
+func BenchmarkIpv4(b *testing.B)            { benchmarkStringRule(b, "Ipv4") }
+func BenchmarkIpv6(b *testing.B)            { benchmarkStringRule(b, "Ipv6") }
+func BenchmarkLuhn(b *testing.B)            { benchmarkStringRule(b, "Luhn") }
+func BenchmarkNatural(b *testing.B)         { benchmarkStringRule(b, "Natural") }
+func BenchmarkNaturalNonZero(b *testing.B)  { benchmarkStringRule(b, "NaturalNonZero") }
+func BenchmarkURL(b *testing.B)             { benchmarkStringRule(b, "URL") }
+func BenchmarkUUID(b *testing.B)            { benchmarkStringRule(b, "UUID") }
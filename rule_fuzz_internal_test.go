@@ -0,0 +1,130 @@
+package checkit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// fuzzRules builds every registered rule with the first argument list it accepts
+func fuzzRules(t testing.TB) Validator {
+	var argLists = [][]interface{}{{}, {1}, {"a"}, {0, 10}, {"a", 1}}
+	var validator = Validator{}
+	for _, name := range DefaultRegistry.Names() {
+		factory, _ := DefaultRegistry.Lookup(name)
+		for _, args := range argLists {
+			if validating, err := factory(args...); err == nil {
+				validator[name] = validating
+				break
+			}
+		}
+		if validator[name] == nil {
+			t.Fatalf("Rule %q accepts none of the fuzz arguments", name)
+		}
+	}
+	return validator
+}
+
+func FuzzValidate(f *testing.F) {
+	f.Add(`null`, "a")
+	f.Add(`{"a": []}`, "a.first")
+	f.Add(`{"a": [], "b": null}`, "a.last.b")
+	f.Add(`[{"a": 1}, {"a": null}, []]`, "any.a")
+	f.Add(`{"all": 5}`, "all.x")
+	f.Add(`[[1, "x", true], {"0": -1.5}]`, "all.0.-1")
+	rules := fuzzRules(f)
+	f.Fuzz(func(t *testing.T, data string, keyPath string) {
+		var value interface{}
+		if err := json.Unmarshal([]byte(data), &value); err != nil {
+			return
+		}
+		var validator = Validator{}
+		for name, validating := range rules {
+			validator[keyPath] = validating
+			validator.ValidateAll(value)
+			validating.Validate(value)
+			if v, ok := validating.(ValidatingContext); ok {
+				v.ValidateContext(context.Background(), value)
+			}
+			delete(validator, name)
+		}
+		Validator{keyPath: Each(CompoundValidating(rulesOf(rules)))}.ValidateAll(value)
+		Validator{keyPath: Or(rulesOf(rules)...)}.ValidateAll(value)
+	})
+}
+
+func rulesOf(validator Validator) []Validating {
+	var validatings []Validating
+	for _, f := range validator.fields() {
+		validatings = append(validatings, f.validating)
+	}
+	return validatings
+}
+
+type panickingRule struct{}
+
+func (panickingRule) Validate(value interface{}) (bool, error) {
+	panic("boom")
+}
+
+func TestValidate_whenRulePanics_shouldReturnPanicError(t *testing.T) {
+	_, err := Validator{"a": panickingRule{}}.ValidateAll(map[string]int{"a": 1})
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Recovered != "boom" {
+		t.Errorf("The panic must be reported, got %v", err)
+	}
+	if _, err := (CompoundValidating{panickingRule{}}).Validate(1); !errors.As(err, &panicErr) {
+		t.Errorf("The panic must be reported, got %v", err)
+	}
+	if _, err := Validate(context.Background(), 1, Validator{"": panickingRule{}}); !errors.As(err, &panicErr) {
+		t.Errorf("The panic must be reported, got %v", err)
+	}
+}
+
+func TestValidate_whenValueMissing_shouldReturnErrValueMissing(t *testing.T) {
+	validator := Validator{
+		"items.first":  String(),
+		"items.last.a": Array(),
+		"items.9":      Boolean(),
+	}
+	_, err := validator.ValidateAll(map[string]interface{}{"items": []interface{}{}})
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 3 {
+		t.Fatalf("Every rule must fail, got %v", err)
+	}
+	for _, fieldErr := range errs {
+		if !errors.Is(fieldErr, ErrValueMissing) {
+			t.Errorf("A missing value must be reported, got %v", fieldErr)
+		}
+	}
+}
+
+func TestValidate_whenValueIsTypedNil_shouldReturnErrValueMissing(t *testing.T) {
+	type value struct {
+		P *int `checkit:"between=1:3"`
+	}
+	_, err := ValidateStruct(value{})
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 1 || !errors.Is(errs[0], ErrValueMissing) {
+		t.Errorf("A nil pointer must be reported as missing, got %v", err)
+	}
+	if _, err := Between(1, 3).Validate((*int)(nil)); !errors.Is(err, ErrValueMissing) {
+		t.Errorf("A nil pointer must be reported as missing, got %v", err)
+	}
+}
+
+func TestValidate_whenValueIsNilSliceOrMap_shouldValidateIt(t *testing.T) {
+	for _, value := range []interface{}{[]int(nil), map[string]int(nil)} {
+		for _, validating := range []Validating{Empty(), MaxLength(3), ExactLength(0), Size(0)} {
+			if r, err := validating.Validate(value); !r {
+				t.Errorf("A nil %T must be validated as empty, got %v", value, err)
+			}
+		}
+	}
+	if r, err := Array().Validate([]int(nil)); !r {
+		t.Errorf("A nil slice must be an array, got %v", err)
+	}
+	if r, err := MinLength(1).Validate([]int(nil)); r || errors.Is(err, ErrValueMissing) {
+		t.Errorf("A nil slice must fail as empty, got %v", err)
+	}
+}
@@ -9,18 +9,58 @@ func TestAccepted(t *testing.T) {
 }
 
 func TestAplha(t *testing.T) {
-
+	validating := Alpha()
+	for _, value := range []interface{}{"abc", "ABc"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"ab1", "a_b", ""} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestAlphaDash(t *testing.T) {
-
+	validating := AlphaDash()
+	for _, value := range []interface{}{"ab-c_1"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"ab c", "ab.c"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 func TestAlphaNumeric(t *testing.T) {
-
+	validating := AlphaNumeric()
+	for _, value := range []interface{}{"abC1"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"ab-1", "ab_1"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestAlphaUnderscore(t *testing.T) {
-
+	validating := AlphaUnderscore()
+	for _, value := range []interface{}{"ab_C1"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"ab-1"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestArray(t *testing.T) {
@@ -28,7 +68,17 @@ func TestArray(t *testing.T) {
 }
 
 func TestBase64(t *testing.T) {
-
+	validating := Base64()
+	for _, value := range []interface{}{"aGVsbG8=", "aGk=", "aGVs"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"aGVsbG8", "a$=="} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestBetween(t *testing.T) {
@@ -63,7 +113,17 @@ func TestDate(t *testing.T) {
 }
 
 func TestEmail(t *testing.T) {
-
+	validating := Email()
+	for _, value := range []interface{}{"a@b.c", "first.last@example.com"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"a@b", "ab.c"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestEmpty(t *testing.T) {
@@ -95,15 +155,45 @@ func TestGreaterThanEqualTo(t *testing.T) {
 }
 
 func TestInteger(t *testing.T) {
-
+	validating := Integer()
+	for _, value := range []interface{}{"-12", "0", 12, int64(-1)} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"1.5", "a", 1.5} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestIpv4(t *testing.T) {
-
+	validating := Ipv4()
+	for _, value := range []interface{}{"127.0.0.1", "255.255.255.255"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"256.0.0.1", "1.2.3", "::1"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestIpv6(t *testing.T) {
-
+	validating := Ipv6()
+	for _, value := range []interface{}{"::1", "2001:db8::8a2e:370:7334", "::ffff:192.0.2.1"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"127.0.0.1", "2001:db8::g", "1::2::3"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestLessThan(t *testing.T) {
@@ -115,7 +205,17 @@ func TestLessThanEqualTo(t *testing.T) {
 }
 
 func TestLuhn(t *testing.T) {
-
+	validating := Luhn()
+	for _, value := range []interface{}{"4111111111111111", "5500000000000004"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"1234", "41111111111111112"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestMaxLength(t *testing.T) {
@@ -127,7 +227,17 @@ func TestMinLength(t *testing.T) {
 }
 
 func TestNatural(t *testing.T) {
-
+	validating := Natural()
+	for _, value := range []interface{}{"0", "12", 0, uint(3)} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"-1", -1} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestNaN(t *testing.T) {
@@ -135,7 +245,17 @@ func TestNaN(t *testing.T) {
 }
 
 func TestNaturalNonZero(t *testing.T) {
-
+	validating := NaturalNonZero()
+	for _, value := range []interface{}{"1", "10", 1} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"0", "01", 0} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestObject(t *testing.T) {
@@ -155,9 +275,86 @@ func TestString(t *testing.T) {
 }
 
 func TestURL(t *testing.T) {
-
+	validating := URL()
+	for _, value := range []interface{}{"http://example.com", "https://example.com:8080/a?b=c#d"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"", "example.com", "ftp://example.com", "http://"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
 }
 
 func TestUUID(t *testing.T) {
+	validating := UUID()
+	for _, value := range []interface{}{"123e4567-e89b-12d3-a456-426614174000", "123E4567-E89B-12D3-A456-426614174000"} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must be valid", value)
+		}
+	}
+	for _, value := range []interface{}{"123e4567-e89b-62d3-a456-426614174000", "123e4567e89b12d3a456426614174000"} {
+		if r, _ := validating.Validate(value); r {
+			t.Errorf("%v must be invalid", value)
+		}
+	}
+}
+
+type testCurrency string
+
+type testStringer struct{}
 
+func (testStringer) String() string { return "ABC" }
+
+func TestMatches(t *testing.T) {
+	validating := MustMatches(`^[A-Z]{3}$`)
+	for _, value := range []interface{}{"ABC", []byte("ABC"), testCurrency("ABC"), testStringer{}} {
+		if r, _ := validating.Validate(value); !r {
+			t.Errorf("%v must match", value)
+		}
+	}
+	_, err := validating.Validate("abc")
+	fieldErr, ok := err.(*FieldError)
+	if !ok || fieldErr.Params["pattern"] != `^[A-Z]{3}$` {
+		t.Errorf("Error must report the pattern, got %v", err)
+	}
+	if _, err := validating.Validate(1); err == nil {
+		t.Errorf("A number must not match")
+	}
+}
+
+func TestMatches_whenPatternDoesNotCompile_shouldReturnError(t *testing.T) {
+	if _, err := Matches(`(`); err == nil {
+		t.Errorf("An invalid pattern must be reported")
+	}
+	if _, err := NotMatches(`(`); err == nil {
+		t.Errorf("An invalid pattern must be reported")
+	}
+}
+
+func TestNotMatches(t *testing.T) {
+	validating := MustNotMatches(`\s`)
+	if r, _ := validating.Validate("abc"); !r {
+		t.Fail()
+	}
+	if r, _ := validating.Validate("a c"); r {
+		t.Fail()
+	}
+}
+
+type testAddress string
+
+func TestIpv6AndURL_shouldAcceptStringLikeValues(t *testing.T) {
+	for _, value := range []interface{}{"::1", []byte("::1"), testAddress("::1")} {
+		if r, err := Ipv6().Validate(value); !r {
+			t.Errorf("%v must be an IPv6 address, got %v", value, err)
+		}
+	}
+	for _, value := range []interface{}{"https://example.com", []byte("https://example.com"), testAddress("https://example.com")} {
+		if r, err := URL().Validate(value); !r {
+			t.Errorf("%v must be a URL, got %v", value, err)
+		}
+	}
 }
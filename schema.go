@@ -0,0 +1,40 @@
+package checkit
+
+// OrderedValidator is a Validator which evaluates key paths in declaration order
+type OrderedValidator struct {
+	fields fields
+}
+
+// Schema starts an OrderedValidator
+//
+//	Schema().Field("a", Between(0, 2)).Field("b", String(), MaxLength(2))
+func Schema() *OrderedValidator {
+	return &OrderedValidator{}
+}
+
+// Field appends the rules of a key path, several rules are combined as a CompoundValidating
+func (o *OrderedValidator) Field(keyPath string, validatings ...Validating) *OrderedValidator {
+	var validating Validating
+	if len(validatings) == 1 {
+		validating = validatings[0]
+	} else {
+		validating = CompoundValidating(validatings)
+	}
+	o.fields = append(o.fields, field{keyPath: keyPath, validating: validating})
+	return o
+}
+
+// ValidateSync ...
+func (o *OrderedValidator) ValidateSync(value interface{}, opts ...Option) (bool, error) {
+	return o.fields.validateSync(value, newOptions(opts))
+}
+
+// MayBeSync ...
+func (o *OrderedValidator) MayBeSync(value interface{}, opts ...Option) (bool, error) {
+	return o.fields.mayBeSync(value, newOptions(opts))
+}
+
+// ValidateAll ...
+func (o *OrderedValidator) ValidateAll(value interface{}, opts ...Option) (bool, error) {
+	return o.fields.validateAll(value, newOptions(opts))
+}
@@ -0,0 +1,56 @@
+package checkit
+
+import (
+	"testing"
+)
+
+func TestSchema_shouldReportErrorsInDeclarationOrder(t *testing.T) {
+	value := map[string]interface{}{
+		"z": "",
+		"a": "",
+		"m": "",
+	}
+	schema := Schema().
+		Field("z", MinLength(1)).
+		Field("a", MinLength(1)).
+		Field("m", MinLength(1))
+	for i := 0; i < 10; i++ {
+		_, err := schema.ValidateAll(value)
+		errs, ok := err.(ValidationErrors)
+		if !ok || len(errs) != 3 {
+			t.Fatalf("Error must be a ValidationErrors of %d, got %v", 3, err)
+		}
+		if errs[0].KeyPath != "z" || errs[1].KeyPath != "a" || errs[2].KeyPath != "m" {
+			t.Fatalf("Errors must follow declaration order, got %v", errs)
+		}
+		_, err = schema.ValidateSync(value)
+		if err.(*FieldError).KeyPath != "z" {
+			t.Fatalf("First error must be of the first declared key path, got %v", err)
+		}
+	}
+}
+
+func TestValidator_shouldReportErrorsInKeyPathOrder(t *testing.T) {
+	value := map[string]interface{}{
+		"b": "",
+		"a": "",
+		"c": "",
+	}
+	for i := 0; i < 10; i++ {
+		_, err := Validator(map[string]Validating{
+			"c": MinLength(1),
+			"b": MinLength(1),
+			"a": MinLength(1),
+		}).ValidateSync(value)
+		if err.(*FieldError).KeyPath != "a" {
+			t.Fatalf("First error must be of the smallest key path, got %v", err)
+		}
+	}
+}
+
+func TestSchemaField_whenManyRules_shouldCombineThem(t *testing.T) {
+	r, _ := Schema().Field("a", String(), MaxLength(2)).ValidateSync(map[string]interface{}{"a": "abc"})
+	if r {
+		t.Fail()
+	}
+}
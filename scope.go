@@ -0,0 +1,113 @@
+package checkit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+type scopeKey struct{}
+
+// validationScope lets rules such as RequiredIf reach the other fields of the validated value
+type validationScope struct {
+	root    interface{}
+	keyPath string // absolute key path of the value under validation
+	absent  bool   // the value under validation is missing rather than nil
+	nilPtr  bool   // the value under validation is a nil pointer field of ValidateStruct, both missing and nil
+}
+
+func withRoot(ctx context.Context, root interface{}) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &validationScope{root: root})
+}
+
+// ensureScope makes the value the root when the rule is not run by a Validator
+func ensureScope(ctx context.Context, value interface{}) context.Context {
+	if _, ok := ctx.Value(scopeKey{}).(*validationScope); ok {
+		return ctx
+	}
+	return withRoot(ctx, value)
+}
+
+func withScopeKeyPath(ctx context.Context, keyPath string) context.Context {
+	scope, ok := ctx.Value(scopeKey{}).(*validationScope)
+	if !ok || len(keyPath) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, scopeKey{}, &validationScope{
+		root:    scope.root,
+		keyPath: joinKeyPath(scope.keyPath, keyPath),
+	})
+}
+
+// withAbsent marks the value under validation as missing, see Optional
+func withAbsent(ctx context.Context) context.Context {
+	return withPresence(ctx, validationScope{absent: true})
+}
+
+// withNilPointer marks the value under validation as a nil pointer field, which Optional and Nullable both skip
+func withNilPointer(ctx context.Context) context.Context {
+	return withPresence(ctx, validationScope{absent: true, nilPtr: true})
+}
+
+func withPresence(ctx context.Context, scope validationScope) context.Context {
+	if current, ok := ctx.Value(scopeKey{}).(*validationScope); ok {
+		scope.root = current.root
+		scope.keyPath = current.keyPath
+	}
+	return context.WithValue(ctx, scopeKey{}, &scope)
+}
+
+func isAbsent(ctx context.Context) bool {
+	scope, ok := ctx.Value(scopeKey{}).(*validationScope)
+	return ok && scope.absent
+}
+
+// isExplicitNil reports a nil value which is present, or a nil pointer field
+func isExplicitNil(ctx context.Context, value interface{}) bool {
+	if !isNil(value) {
+		return false
+	}
+	scope, ok := ctx.Value(scopeKey{}).(*validationScope)
+	return !ok || !scope.absent || scope.nilPtr
+}
+
+func scopeOf(ctx context.Context, value interface{}) *validationScope {
+	if scope, ok := ctx.Value(scopeKey{}).(*validationScope); ok {
+		return scope
+	}
+	return &validationScope{root: value}
+}
+
+// resolve returns the value at a key path from the root,
+// or from the parent of the value under validation when the key path starts with a dot
+// The first value of a multi-value parameter such as a query parameter is returned,
+// a json.Number is converted like the values given to rules.
+func (s *validationScope) resolve(keyPath string) interface{} {
+	if strings.HasPrefix(keyPath, ".") {
+		var parentKeyPath string
+		if i := strings.LastIndex(s.keyPath, "."); i >= 0 {
+			parentKeyPath = s.keyPath[:i]
+		}
+		keyPath = joinKeyPath(parentKeyPath, keyPath[1:])
+	}
+	value := firstValueOf(valueForKeyPath(s.root, keyPath))
+	if n, ok := value.(json.Number); ok {
+		return numberOf(n)
+	}
+	return value
+}
+
+// isNil reports whether the value is nil or a nil pointer, map, slice, channel, function or interface
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,63 @@
+package checkit
+
+import (
+	"io"
+	"mime/multipart"
+	"os"
+	"reflect"
+	"unicode/utf8"
+)
+
+type sizer interface {
+	Size() int64
+}
+
+type lener interface {
+	Len() int
+}
+
+// sizeOf measures a value for the size rules: numbers are their own size, strings count their runes,
+// collections count their elements, and files count their bytes.
+// A reader is measured only when it tells its size, e.g. a *bytes.Reader, a plain io.Reader is not read.
+func sizeOf(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, newInternalError("The size of nil is unknown")
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v, nil
+	case string:
+		return utf8.RuneCountInString(v), nil
+	case *multipart.FileHeader:
+		return v.Size, nil
+	case os.FileInfo:
+		return v.Size(), nil
+	case sizer:
+		return v.Size(), nil
+	case lener:
+		return v.Len(), nil
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil {
+			return nil, newInternalError(err.Error())
+		}
+		return info.Size(), nil
+	case io.Reader:
+		return nil, newInternalError("The size of the reader is unknown")
+	}
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(val.String()), nil
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice:
+		return val.Len(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return val.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return val.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return val.Float(), nil
+	default:
+		return nil, newInternalError("The size of the value is unknown")
+	}
+}
@@ -0,0 +1,74 @@
+package checkit
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"strings"
+	"testing"
+)
+
+type testQuantity int
+
+type testWeight float32
+
+func TestSizeOf(t *testing.T) {
+	cases := []struct {
+		value interface{}
+		size  interface{}
+	}{
+		{uint8(7), uint8(7)},
+		{-1.5, -1.5},
+		{"héllo", 5},
+		{testCurrency("VND"), 3},
+		{[]int{1, 2}, 2},
+		{map[string]int{"a": 1}, 1},
+		{strings.NewReader("abc"), int64(3)},
+		{bytes.NewBufferString("abcd"), 4},
+		{&multipart.FileHeader{Size: 1024}, int64(1024)},
+		{testQuantity(4), int64(4)},
+		{testWeight(1.5), 1.5},
+	}
+	for _, c := range cases {
+		size, err := sizeOf(c.value)
+		if err != nil || size != c.size {
+			t.Errorf("Size of %v must be %v, got %v (%v)", c.value, c.size, size, err)
+		}
+	}
+	for _, value := range []interface{}{nil, struct{}{}, multipart.File(nil), io.MultiReader()} {
+		if _, err := sizeOf(value); err == nil {
+			t.Errorf("Size of %v must be unknown", value)
+		}
+	}
+}
+
+func TestSize(t *testing.T) {
+	if r, _ := Size(3).Validate("abc"); !r {
+		t.Fail()
+	}
+	if r, _ := Size(3).Validate([]int{1}); r {
+		t.Fail()
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	file := &multipart.FileHeader{Size: 2048}
+	if r, _ := Max(1024).Validate(file); r {
+		t.Errorf("File must be larger than the maximum")
+	}
+	if r, _ := Min(1024).Validate(file); !r {
+		t.Errorf("File must be larger than the minimum")
+	}
+	if r, _ := Max(10).Validate(testQuantity(11)); r {
+		t.Errorf("A named numeric type must be compared by value")
+	}
+	if r, _ := Max(10).Validate(uint(10)); !r {
+		t.Errorf("Maximum must be inclusive")
+	}
+	if r, _ := Min(2).Validate("ab"); !r {
+		t.Errorf("Minimum must be inclusive")
+	}
+	if r, _ := Min(0.5).Validate(0.25); r {
+		t.Errorf("Number must be less than the minimum")
+	}
+}
@@ -0,0 +1,217 @@
+package checkit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+const tagName = "checkit"
+
+// ValidateStruct validates a struct with the rules declared in its `checkit` tags.
+// Nested structs, and structs held by slices, arrays and maps are validated as well.
+//
+//	type Order struct {
+//		Quantity int    `checkit:"required,between=1:10"`
+//		Email    string `checkit:"email,maxLength=255"`
+//	}
+//
+// Rules are separated by commas, arguments follow the rule name after "=" and are separated by colons,
+// they are read the same way as by Parse.
+// Every failure is reported, the error is a ValidationErrors keyed by field names.
+func ValidateStruct(value interface{}, opts ...Option) (bool, error) {
+	return DefaultRegistry.ValidateStruct(value, opts...)
+}
+
+// ValidateStruct validates a struct with rules looked up in the registry
+func (r *Registry) ValidateStruct(value interface{}, opts ...Option) (bool, error) {
+	v := flattenReflectValue(reflect.ValueOf(value))
+	switch v.Kind() {
+	case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+		break
+	default:
+		return false, fmt.Errorf("ValidateStruct expects a struct, got %T", value)
+	}
+	var errs ValidationErrors
+	o := newOptions(opts)
+	ctx := withOptions(withRoot(context.Background(), value), o)
+	if err := r.validateStructValue(ctx, reflect.ValueOf(value), "", map[visitedReference]bool{}, &errs); err != nil {
+		return false, err
+	}
+	if err := lookupErrorOf(errs); err != nil {
+		return false, err
+	}
+	if len(errs) > 0 {
+		return false, ValidationErrors(o.localizeAll(errs))
+	}
+	return true, nil
+}
+
+type structField struct {
+	index      int
+	name       string
+	validating Validating
+	descend    bool
+}
+
+type structPlan struct {
+	fields []structField
+	err    error
+}
+
+// visitedReference identifies a pointer or a map on the way from the root,
+// the type tells a pointer to a struct from a pointer to its first field
+type visitedReference struct {
+	pointer uintptr
+	t       reflect.Type
+}
+
+// validateStructValue descends into the value, a pointer or map met again on the way from the root is a cycle
+// and is not descended into twice
+func (r *Registry) validateStructValue(ctx context.Context, value reflect.Value, keyPath string, visiting map[visitedReference]bool, errs *ValidationErrors) error {
+	ref := value
+	for ref.Kind() == reflect.Interface && !ref.IsNil() {
+		ref = ref.Elem()
+	}
+	if (ref.Kind() == reflect.Ptr || ref.Kind() == reflect.Map) && !ref.IsNil() {
+		visited := visitedReference{pointer: ref.Pointer(), t: ref.Type()}
+		if visiting[visited] {
+			return nil
+		}
+		visiting[visited] = true
+		defer delete(visiting, visited)
+	}
+	v := flattenReflectValue(value)
+	switch v.Kind() {
+	case reflect.Struct:
+		plan := r.structPlanOf(v.Type())
+		if plan.err != nil {
+			return plan.err
+		}
+		for _, f := range plan.fields {
+			fieldValue := v.Field(f.index)
+			fieldKeyPath := joinKeyPath(keyPath, f.name)
+			if f.validating != nil {
+				fieldCtx := withScopeKeyPath(ctx, fieldKeyPath)
+				if fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil() {
+					fieldCtx = withNilPointer(fieldCtx)
+				}
+				*errs = append(*errs, collectScopedFieldErrors(fieldCtx, f.validating, getReferenceValue(fieldValue), fieldKeyPath, false)...)
+			}
+			if !f.descend {
+				continue
+			}
+			if err := r.validateStructValue(ctx, fieldValue, fieldKeyPath, visiting, errs); err != nil {
+				return err
+			}
+		}
+	case reflect.Array, reflect.Slice:
+		if !typeHasRules(v.Type().Elem()) {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := r.validateStructValue(ctx, v.Index(i), joinKeyPath(keyPath, strconv.Itoa(i)), visiting, errs); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if !typeHasRules(v.Type().Elem()) {
+			return nil
+		}
+		mapKeys := v.MapKeys()
+		var keys = make([]string, len(mapKeys))
+		for i, mapKey := range mapKeys {
+			keys[i] = fmt.Sprint(getReferenceValue(mapKey))
+		}
+		sort.Sort(mapKeysByString{keys: keys, values: mapKeys})
+		for i, mapKey := range mapKeys {
+			if err := r.validateStructValue(ctx, v.MapIndex(mapKey), joinKeyPath(keyPath, keys[i]), visiting, errs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type mapKeysByString struct {
+	keys   []string
+	values []reflect.Value
+}
+
+func (m mapKeysByString) Len() int           { return len(m.keys) }
+func (m mapKeysByString) Less(i, j int) bool { return m.keys[i] < m.keys[j] }
+func (m mapKeysByString) Swap(i, j int) {
+	m.keys[i], m.keys[j] = m.keys[j], m.keys[i]
+	m.values[i], m.values[j] = m.values[j], m.values[i]
+}
+
+func (r *Registry) structPlanOf(t reflect.Type) *structPlan {
+	if cached, ok := r.structPlans.Load(t); ok {
+		return cached.(*structPlan)
+	}
+	plan := r.buildStructPlan(t)
+	cached, _ := r.structPlans.LoadOrStore(t, plan)
+	return cached.(*structPlan)
+}
+
+func (r *Registry) buildStructPlan(t reflect.Type) *structPlan {
+	var plan = &structPlan{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		f := structField{index: i, name: sf.Name}
+		if len(tag) > 0 {
+			validating, err := r.parseTag(tag)
+			if err != nil {
+				plan.err = fmt.Errorf("checkit tag of %s.%s: %w", t.Name(), sf.Name, err)
+				return plan
+			}
+			f.validating = validating
+		}
+		f.descend = typeHasRules(sf.Type)
+		plan.fields = append(plan.fields, f)
+	}
+	return plan
+}
+
+// typeHasRules reports whether values of the type may hold a struct declaring rules
+func typeHasRules(t reflect.Type) bool {
+	return typeHasRulesVisiting(t, map[reflect.Type]bool{})
+}
+
+func typeHasRulesVisiting(t reflect.Type, visiting map[reflect.Type]bool) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Array, reflect.Slice, reflect.Map:
+		return typeHasRulesVisiting(t.Elem(), visiting)
+	case reflect.Struct:
+		if visiting[t] {
+			return true
+		}
+		visiting[t] = true
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get(tagName)
+			if tag == "-" {
+				continue
+			}
+			if len(tag) > 0 || typeHasRulesVisiting(sf.Type, visiting) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func (r *Registry) parseTag(tag string) (Validating, error) {
+	p := &ruleParser{registry: r, input: tag, separator: ',', argsStart: '='}
+	return p.parse()
+}
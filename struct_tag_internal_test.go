@@ -0,0 +1,103 @@
+package checkit
+
+import (
+	"reflect"
+	"testing"
+)
+
+type taggedAddress struct {
+	City string `checkit:"required,minLength=1"`
+}
+
+type taggedOrder struct {
+	Quantity  uint8  `checkit:"between=1:10"`
+	Email     string `checkit:"string, maxLength=255"`
+	Note      string `checkit:"-"`
+	Address   *taggedAddress
+	Addresses []taggedAddress
+	ByName    map[string]taggedAddress
+}
+
+func TestValidateStruct_whenValid_shouldPass(t *testing.T) {
+	r, err := ValidateStruct(taggedOrder{
+		Quantity: 2,
+		Email:    "a@b.c",
+		Address:  &taggedAddress{City: "Hanoi"},
+	})
+	if !r || err != nil {
+		t.Errorf("Struct must be valid, got %v", err)
+	}
+}
+
+func TestValidateStruct_shouldReportNestedFailures(t *testing.T) {
+	_, err := ValidateStruct(&taggedOrder{
+		Quantity:  11,
+		Email:     "a@b.c",
+		Address:   &taggedAddress{},
+		Addresses: []taggedAddress{taggedAddress{City: "Hue"}, taggedAddress{}},
+		ByName:    map[string]taggedAddress{"home": taggedAddress{}},
+	})
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Error must be a ValidationErrors, got %v", err)
+	}
+	var keyPaths []string
+	for _, fieldErr := range errs {
+		keyPaths = append(keyPaths, fieldErr.KeyPath)
+	}
+	expected := []string{"Quantity", "Address.City", "Addresses.1.City", "ByName.home.City"}
+	if !reflect.DeepEqual(keyPaths, expected) {
+		t.Errorf("Key paths must be %v, got %v", expected, keyPaths)
+	}
+}
+
+func TestValidateStruct_whenRuleIsUnknown_shouldReturnError(t *testing.T) {
+	type invalid struct {
+		A int `checkit:"unknown"`
+	}
+	_, err := ValidateStruct(invalid{})
+	if _, ok := err.(ValidationErrors); ok || err == nil {
+		t.Errorf("Error must describe the invalid tag, got %v", err)
+	}
+}
+
+func TestStructPlanOf_shouldBeCached(t *testing.T) {
+	typ := reflect.TypeOf(taggedAddress{})
+	if DefaultRegistry.structPlanOf(typ) != DefaultRegistry.structPlanOf(typ) {
+		t.Errorf("Plan must be parsed once per type")
+	}
+}
+
+func TestValidateStruct_shouldTreatNilPointersAsAbsent(t *testing.T) {
+	type profile struct {
+		Nick  *string `checkit:"optional,minLength=3"`
+		Email *string `checkit:"nullable,email"`
+		Bio   *string `checkit:"minLength=3"`
+	}
+	if r, err := ValidateStruct(profile{}); r || err == nil {
+		t.Errorf("A nil pointer without marker must be validated, got %v", err)
+	} else if errs := err.(ValidationErrors); len(errs) != 1 || errs[0].KeyPath != "Bio" {
+		t.Errorf("Only Bio must fail, got %v", err)
+	}
+	nick := "ab"
+	_, err := ValidateStruct(profile{Nick: &nick})
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 2 || errs[0].KeyPath != "Nick" || errs[0].Rule != "min_length" {
+		t.Errorf("A present optional value must be validated, got %v", err)
+	}
+}
+
+type taggedNode struct {
+	Name string `checkit:"minLength=1"`
+	Next *taggedNode
+	Refs map[string]interface{}
+}
+
+func TestValidateStruct_shouldStopAtCycles(t *testing.T) {
+	node := &taggedNode{Refs: map[string]interface{}{}}
+	node.Next = node
+	node.Refs["self"] = node.Refs
+	_, err := ValidateStruct(node)
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 1 || errs[0].KeyPath != "Name" {
+		t.Errorf("A cycle must be validated once, got %v", err)
+	}
+}